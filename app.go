@@ -6,18 +6,21 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/wirelineio/registry/x/admin"
 	"github.com/wirelineio/registry/x/htlc"
 	"github.com/wirelineio/registry/x/multisig"
 	msighandler "github.com/wirelineio/registry/x/multisig/handlers"
@@ -39,21 +42,36 @@ type registryApp struct {
 	keyAccount       *sdk.KVStoreKey
 	keyFeeCollection *sdk.KVStoreKey
 	keyTxStore       *sdk.KVStoreKey
-
-	keyHtlcStore     *sdk.KVStoreKey
-	keyMultisigStore *sdk.KVStoreKey
-	keyAccUtxoStore  *sdk.KVStoreKey
-	keyUtxoStore     *sdk.KVStoreKey
-	keyRegStore      *sdk.KVStoreKey
+	keyParams        *sdk.KVStoreKey
+	tkeyParams       *sdk.TransientStoreKey
+	keySupply        *sdk.KVStoreKey
+
+	keyAdminStore       *sdk.KVStoreKey
+	keyHtlcStore        *sdk.KVStoreKey
+	keyMultisigStore    *sdk.KVStoreKey
+	keyAccUtxoStore     *sdk.KVStoreKey
+	keyUtxoStore        *sdk.KVStoreKey
+	keyUtxoAddressStore *sdk.KVStoreKey
+	keyRegStore         *sdk.KVStoreKey
+	keyRegRevisionStore *sdk.KVStoreKey
+	keyRegIndexStore    *sdk.KVStoreKey
+	keyRegNameStore     *sdk.KVStoreKey
+	keyRegMetadataStore *sdk.KVStoreKey
 
 	accountKeeper       auth.AccountKeeper
 	bankKeeper          bank.Keeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
+	paramsKeeper        params.Keeper
 
+	adminKeeper    admin.Keeper
 	htlcKeeper     htlc.Keeper
 	multisigKeeper msighandler.Keeper
 	utxoKeeper     utxo.Keeper
 	regKeeper      registry.Keeper
+
+	// Results of the block being executed, published to GQL subscribers once the block is committed.
+	notifier *gql.Notifier
+	block    gql.CommittedBlock
 }
 
 // NewRegistryApp is a constructor function for registryApp
@@ -75,12 +93,21 @@ func NewRegistryApp(logger log.Logger, db dbm.DB) *registryApp {
 		keyAccount:       sdk.NewKVStoreKey("acc"),
 		keyFeeCollection: sdk.NewKVStoreKey("fee_collection"),
 		keyTxStore:       sdk.NewKVStoreKey("tx"),
-
-		keyHtlcStore:     sdk.NewKVStoreKey("htlc"),
-		keyMultisigStore: sdk.NewKVStoreKey("multisig"),
-		keyAccUtxoStore:  sdk.NewKVStoreKey("acc_utxo"),
-		keyUtxoStore:     sdk.NewKVStoreKey("utxo"),
-		keyRegStore:      sdk.NewKVStoreKey("registry"),
+		keyParams:        sdk.NewKVStoreKey("params"),
+		tkeyParams:       sdk.NewTransientStoreKey("transient_params"),
+		keySupply:        sdk.NewKVStoreKey("supply"),
+
+		keyAdminStore:       sdk.NewKVStoreKey("admin"),
+		keyHtlcStore:        sdk.NewKVStoreKey("htlc"),
+		keyMultisigStore:    sdk.NewKVStoreKey("multisig"),
+		keyAccUtxoStore:     sdk.NewKVStoreKey("acc_utxo"),
+		keyUtxoStore:        sdk.NewKVStoreKey("utxo"),
+		keyUtxoAddressStore: sdk.NewKVStoreKey("utxo_address"),
+		keyRegStore:         sdk.NewKVStoreKey("registry"),
+		keyRegRevisionStore: sdk.NewKVStoreKey("registry_revision"),
+		keyRegIndexStore:    sdk.NewKVStoreKey("registry_index"),
+		keyRegNameStore:     sdk.NewKVStoreKey("registry_name"),
+		keyRegMetadataStore: sdk.NewKVStoreKey("registry_metadata"),
 	}
 
 	// The AccountKeeper handles address -> account lookups
@@ -96,16 +123,27 @@ func NewRegistryApp(logger log.Logger, db dbm.DB) *registryApp {
 	// The FeeCollectionKeeper collects transaction fees and renders them to the fee distribution module
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(cdc, app.keyFeeCollection)
 
-	app.htlcKeeper = htlc.NewKeeper(app.bankKeeper, app.keyHtlcStore, app.cdc)
+	// The ParamsKeeper handles parameter storage for the application
+	app.paramsKeeper = params.NewKeeper(app.cdc, app.keyParams, app.tkeyParams)
 
-	app.multisigKeeper = msighandler.NewKeeper(app.bankKeeper, app.keyMultisigStore, app.cdc)
+	app.adminKeeper = admin.NewKeeper(app.keyAdminStore, app.cdc)
 
-	app.utxoKeeper = utxo.NewKeeper(app.accountKeeper, app.bankKeeper, app.keyAccUtxoStore, app.keyUtxoStore, app.keyTxStore, app.cdc)
+	app.htlcKeeper = htlc.NewKeeper(app.bankKeeper, app.keyHtlcStore, app.paramsKeeper.Subspace(htlc.DefaultParamspace), app.cdc)
 
-	app.regKeeper = registry.NewKeeper(app.accountKeeper, app.bankKeeper, app.keyRegStore, app.cdc)
+	app.multisigKeeper = msighandler.NewKeeper(app.bankKeeper, app.keyMultisigStore, app.paramsKeeper.Subspace(msighandler.DefaultParamspace), app.cdc)
 
-	// The AnteHandler handles signature verification and transaction pre-processing
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
+	app.utxoKeeper = utxo.NewKeeper(app.accountKeeper, app.bankKeeper, app.keyAccUtxoStore, app.keyUtxoStore, app.keyUtxoAddressStore, app.keyTxStore, app.paramsKeeper.Subspace(utxo.DefaultParamspace), app.cdc)
+
+	app.regKeeper = registry.NewKeeper(app.accountKeeper, app.adminKeeper, app.bankKeeper, app.feeCollectionKeeper, app.keyRegStore, app.keyRegRevisionStore, app.keyRegIndexStore, app.keyRegNameStore, app.keyRegMetadataStore, app.paramsKeeper.Subspace(registry.DefaultParamspace), app.cdc)
+
+	// The AnteHandler handles signature verification and transaction pre-processing.
+	// Messages that clear module state are only allowed on dev networks (or for admins).
+	app.SetAnteHandler(admin.NewAnteHandler(
+		app.adminKeeper,
+		auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper),
+		registry.MsgClearRecords{},
+		htlc.MsgClearHtlc{},
+	))
 
 	// The app.Router is the main transaction router where each module registers its routes
 	// Register the bank and registry routes here
@@ -118,39 +156,188 @@ func NewRegistryApp(logger log.Logger, db dbm.DB) *registryApp {
 
 	// The app.QueryRouter is the main query router where each module registers its routes
 	app.QueryRouter().
+		AddRoute("admin", admin.NewQuerier(app.adminKeeper)).
+		AddRoute("htlc", htlc.NewQuerier(app.htlcKeeper)).
 		AddRoute("multisig", msighandler.NewQuerier(app.multisigKeeper)).
-		AddRoute("utxo", registry.NewQuerier(app.regKeeper)).
+		AddRoute("utxo", utxo.NewQuerier(app.utxoKeeper)).
 		AddRoute("registry", registry.NewQuerier(app.regKeeper))
 
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	app.SetInitChainer(app.initChainer)
 
+	// The endBlocker prunes expired records
+	app.SetEndBlocker(app.endBlocker)
+
 	app.MountStores(
 		app.keyMain,
 		app.keyAccount,
 		app.keyTxStore,
 		app.keyFeeCollection,
+		app.keyParams,
+		app.keySupply,
 
+		app.keyAdminStore,
 		app.keyHtlcStore,
 		app.keyMultisigStore,
 		app.keyAccUtxoStore,
 		app.keyUtxoStore,
+		app.keyUtxoAddressStore,
 		app.keyRegStore,
+		app.keyRegRevisionStore,
+		app.keyRegIndexStore,
+		app.keyRegNameStore,
+		app.keyRegMetadataStore,
 	)
 
+	app.MountStoresTransient(app.tkeyParams)
+
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
 		cmn.Exit(err.Error())
 	}
 
-	go gql.Server(app.BaseApp, app.cdc, app.regKeeper, app.accountKeeper)
+	app.notifier = gql.NewNotifier()
+
+	go gql.Server(app.BaseApp, app.cdc, app.regKeeper, app.accountKeeper, app.htlcKeeper, app.multisigKeeper, app.utxoKeeper, app.notifier)
 
 	return app
 }
 
-// GenesisState represents chain state at the start of the chain. Any initial state (account balances) are stored here.
+// GenesisState represents chain state at the start of the chain. Any initial state (account balances, registry records,
+// escrowed HTLC/multisig/UTXO funds) are stored here.
 type GenesisState struct {
-	Accounts []*auth.BaseAccount `json:"accounts"`
+	Accounts      []*auth.BaseAccount      `json:"accounts"`
+	CollectedFees sdk.Coins                `json:"collected_fees"`
+	Admin         admin.GenesisState       `json:"admin"`
+	Registry      registry.GenesisState    `json:"registry"`
+	Htlc          htlc.GenesisState        `json:"htlc"`
+	Multisig      msighandler.GenesisState `json:"multisig"`
+	Utxo          utxo.GenesisState        `json:"utxo"`
+}
+
+// NewDefaultGenesisState returns the genesis state of a new chain.
+func NewDefaultGenesisState() GenesisState {
+	return GenesisState{
+		Accounts:      []*auth.BaseAccount{},
+		CollectedFees: sdk.Coins{},
+		Admin:         admin.DefaultGenesisState(),
+		Registry:      registry.DefaultGenesisState(),
+		Htlc:          htlc.DefaultGenesisState(),
+		Multisig:      msighandler.DefaultGenesisState(),
+		Utxo:          utxo.DefaultGenesisState(),
+	}
+}
+
+// ValidateGenesisState checks that the genesis state is consistent (e.g. no duplicate accounts or records).
+func ValidateGenesisState(genesisState GenesisState) error {
+	addresses := make(map[string]bool)
+	for _, acc := range genesisState.Accounts {
+		if addresses[acc.Address.String()] {
+			return fmt.Errorf("duplicate account %s in genesis state", acc.Address)
+		}
+		addresses[acc.Address.String()] = true
+	}
+
+	if !genesisState.CollectedFees.IsValid() {
+		return fmt.Errorf("invalid collected fees %s in genesis state", genesisState.CollectedFees)
+	}
+
+	if err := admin.ValidateGenesis(genesisState.Admin); err != nil {
+		return fmt.Errorf("invalid admin genesis state: %s", err)
+	}
+
+	if err := registry.ValidateGenesis(genesisState.Registry); err != nil {
+		return fmt.Errorf("invalid registry genesis state: %s", err)
+	}
+
+	if err := htlc.ValidateGenesis(genesisState.Htlc); err != nil {
+		return fmt.Errorf("invalid htlc genesis state: %s", err)
+	}
+
+	if err := msighandler.ValidateGenesis(genesisState.Multisig); err != nil {
+		return fmt.Errorf("invalid multisig genesis state: %s", err)
+	}
+
+	if err := utxo.ValidateGenesis(genesisState.Utxo); err != nil {
+		return fmt.Errorf("invalid utxo genesis state: %s", err)
+	}
+
+	return nil
+}
+
+// GetSupply returns the total coins in the genesis state, i.e. account balances, collected fees and escrowed funds
+// (registry fee escrows, unspent UTXOs, HTLCs that haven't been redeemed or failed, and multisig contract balances).
+func (genesisState GenesisState) GetSupply() (sdk.Coins, error) {
+	supply := sdk.Coins{}
+	for _, acc := range genesisState.Accounts {
+		supply = supply.Plus(acc.Coins)
+	}
+
+	supply = supply.Plus(genesisState.CollectedFees)
+
+	utxoEscrow, err := genesisState.Utxo.GetEscrowedCoins()
+	if err != nil {
+		return nil, err
+	}
+
+	supply = supply.Plus(genesisState.Registry.GetEscrowedCoins())
+	supply = supply.Plus(genesisState.Htlc.GetEscrowedCoins())
+	supply = supply.Plus(genesisState.Multisig.GetEscrowedCoins())
+	supply = supply.Plus(utxoEscrow)
+
+	return supply, nil
+}
+
+var genesisSupplyKey = []byte("genesis")
+
+// setGenesisSupply saves the supply of the genesis state. Coins are neither minted nor burned after genesis (fees
+// and rent go to the fee collector, and escrowed funds are held by the modules), so the supply never changes.
+func (app *registryApp) setGenesisSupply(ctx sdk.Context, supply sdk.Coins) {
+	store := ctx.KVStore(app.keySupply)
+	// Length prefixed, as the bare encoding of an empty supply is empty (which the store doesn't allow).
+	store.Set(genesisSupplyKey, app.cdc.MustMarshalBinaryLengthPrefixed(supply))
+}
+
+// getGenesisSupply gets the supply of the genesis state, if it was saved (it isn't for chains initialized before
+// the supply was saved).
+func (app *registryApp) getGenesisSupply(ctx sdk.Context) (sdk.Coins, bool) {
+	store := ctx.KVStore(app.keySupply)
+
+	bz := store.Get(genesisSupplyKey)
+	if bz == nil {
+		return nil, false
+	}
+
+	supply := sdk.Coins{}
+	app.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
+
+	return supply, true
+}
+
+// checkExport checks that the exported app state decodes to a valid genesis state, with the same supply as the
+// genesis state the chain was started from. The supply is checked against the one saved at genesis, rather than
+// against the current state, which the export is generated from.
+func (app *registryApp) checkExport(ctx sdk.Context, appState json.RawMessage) error {
+	var exported GenesisState
+	if err := app.cdc.UnmarshalJSON(appState, &exported); err != nil {
+		return fmt.Errorf("error decoding the exported state: %s", err)
+	}
+
+	if err := ValidateGenesisState(exported); err != nil {
+		return fmt.Errorf("invalid exported state: %s", err)
+	}
+
+	supply, err := exported.GetSupply()
+	if err != nil {
+		return err
+	}
+
+	// The supply check is skipped for chains initialized before the genesis supply was saved.
+	if genesisSupply, found := app.getGenesisSupply(ctx); found && !supply.IsEqual(genesisSupply) {
+		return fmt.Errorf("exported supply %s doesn't match the genesis supply %s", supply, genesisSupply)
+	}
+
+	return nil
 }
 
 func (app *registryApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
@@ -162,17 +349,82 @@ func (app *registryApp) initChainer(ctx sdk.Context, req abci.RequestInitChain)
 		panic(err)
 	}
 
+	if err := ValidateGenesisState(*genesisState); err != nil {
+		panic(err)
+	}
+
+	supply, err := genesisState.GetSupply()
+	if err != nil {
+		panic(err)
+	}
+
+	app.setGenesisSupply(ctx, supply)
+
 	for _, acc := range genesisState.Accounts {
 		acc.AccountNumber = app.accountKeeper.GetNextAccountNumber(ctx)
 		app.accountKeeper.SetAccount(ctx, acc)
 	}
 
+	app.feeCollectionKeeper.AddCollectedFees(ctx, genesisState.CollectedFees)
+
+	admin.InitGenesis(ctx, app.adminKeeper, genesisState.Admin)
+	registry.InitGenesis(ctx, app.regKeeper, genesisState.Registry)
+	htlc.InitGenesis(ctx, app.htlcKeeper, genesisState.Htlc)
+	msighandler.InitGenesis(ctx, app.multisigKeeper, genesisState.Multisig)
+	utxo.InitGenesis(ctx, app.utxoKeeper, genesisState.Utxo)
+
 	return abci.ResponseInitChain{}
 }
 
+func (app *registryApp) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	tags := registry.EndBlocker(ctx, app.regKeeper)
+	app.block.EndBlockTags = tags.ToKVPairs()
+
+	// Capture the changed records at the end of the block, as subscribers read them after later blocks.
+	app.block.CaptureRecordChanges(ctx, app.regKeeper)
+
+	return abci.ResponseEndBlock{
+		Tags: tags,
+	}
+}
+
+// BeginBlock implements abci.Application, starting to collect the results of the block for GQL subscribers.
+func (app *registryApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.block = gql.CommittedBlock{
+		Height: req.Header.Height,
+		Hash:   fmt.Sprintf("%X", req.Hash),
+		Time:   req.Header.Time,
+	}
+
+	return app.BaseApp.BeginBlock(req)
+}
+
+// DeliverTx implements abci.Application, adding the tx result to the results of the block.
+func (app *registryApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(txBytes)
+
+	app.block.Txs = append(app.block.Txs, gql.CommittedTx{
+		Hash: fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()),
+		Code: res.Code,
+		Log:  res.Log,
+		Tags: res.Tags,
+	})
+
+	return res
+}
+
+// Commit implements abci.Application, publishing the results of the block to GQL subscribers once it's committed.
+func (app *registryApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	app.notifier.Publish(app.block)
+
+	return res
+}
+
 // ExportAppStateAndValidators does the things
 func (app *registryApp) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
-	ctx := app.NewContext(true, abci.Header{})
+	// Heights are exported relative to the latest block, as the chain restarts from the export at height 1.
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
 	accounts := []*auth.BaseAccount{}
 
 	appendAccountsFn := func(acc auth.Account) bool {
@@ -187,12 +439,26 @@ func (app *registryApp) ExportAppStateAndValidators() (appState json.RawMessage,
 
 	app.accountKeeper.IterateAccounts(ctx, appendAccountsFn)
 
-	genState := GenesisState{Accounts: accounts}
+	genState := GenesisState{
+		Accounts:      accounts,
+		CollectedFees: app.feeCollectionKeeper.GetCollectedFees(ctx),
+		Admin:         admin.ExportGenesis(ctx, app.adminKeeper),
+		Registry:      registry.ExportGenesis(ctx, app.regKeeper),
+		Htlc:          htlc.ExportGenesis(ctx, app.htlcKeeper),
+		Multisig:      msighandler.ExportGenesis(ctx, app.multisigKeeper),
+		Utxo:          utxo.ExportGenesis(ctx, app.utxoKeeper),
+	}
+
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// Invariant: funds are neither created nor lost, by the chain or by the export.
+	if err := app.checkExport(ctx, appState); err != nil {
+		return nil, nil, err
+	}
+
 	return appState, validators, err
 }
 
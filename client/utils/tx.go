@@ -0,0 +1,136 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package utils
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/wirelineio/registry/x/registry"
+)
+
+// SimulationTag is a tag that would be emitted by a simulated tx.
+type SimulationTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SimulationError is the error of a simulated tx.
+type SimulationError struct {
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+	Message   string `json:"message"`
+}
+
+// SimulationResult is the result of a simulated tx.
+type SimulationResult struct {
+	GasWanted uint64           `json:"gas_wanted"`
+	GasUsed   uint64           `json:"gas_used"`
+	Log       string           `json:"log"`
+	Tags      []SimulationTag  `json:"tags"`
+	Error     *SimulationError `json:"error"`
+}
+
+// CompleteAndBroadcastTxCli builds, signs and broadcasts a tx (see the SDK's utils.CompleteAndBroadcastTxCli).
+// With --dry-run (cliCtx.Simulate), the tx is simulated instead and the result (gas, tags and error) printed, without broadcasting it.
+func CompleteAndBroadcastTxCli(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	if !cliCtx.Simulate {
+		return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, msgs)
+	}
+
+	result, err := SimulateTx(txBldr, cliCtx, msgs)
+	if err != nil {
+		return err
+	}
+
+	output, err := cliCtx.Codec.MarshalJSONIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+
+	return nil
+}
+
+// SimulateTx runs a tx with the given msgs in simulate mode on the node, signed by the --from key (without
+// asking for its passphrase, as signatures aren't verified).
+func SimulateTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (*SimulationResult, error) {
+	if err := cliCtx.EnsureAccountExists(); err != nil {
+		return nil, err
+	}
+
+	name, err := cliCtx.GetFromName()
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := cliCtx.GetFromAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	if txBldr.AccountNumber == 0 {
+		accNum, err := cliCtx.GetAccountNumber(from)
+		if err != nil {
+			return nil, err
+		}
+		txBldr = txBldr.WithAccountNumber(accNum)
+	}
+
+	if txBldr.Sequence == 0 {
+		accSeq, err := cliCtx.GetAccountSequence(from)
+		if err != nil {
+			return nil, err
+		}
+		txBldr = txBldr.WithSequence(accSeq)
+	}
+
+	txBytes, err := txBldr.BuildWithPubKey(name, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cliCtx.Query("/app/simulate", txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var result sdk.Result
+	err = cliCtx.Codec.UnmarshalBinaryLengthPrefixed(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSimulationResult(result), nil
+}
+
+// NewSimulationResult creates a SimulationResult from the result of a simulated tx.
+func NewSimulationResult(result sdk.Result) *SimulationResult {
+	tags := make([]SimulationTag, len(result.Tags))
+	for index, tag := range result.Tags {
+		tags[index] = SimulationTag{Key: string(tag.Key), Value: string(tag.Value)}
+	}
+
+	simulationResult := SimulationResult{
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Log:       result.Log,
+		Tags:      tags,
+	}
+
+	if !result.IsOK() {
+		simulationResult.Error = &SimulationError{
+			Codespace: string(result.Codespace),
+			Code:      uint32(result.Code),
+			Message:   registry.GetTxErrorMessage(result.Log),
+		}
+	}
+
+	return &simulationResult
+}
@@ -22,6 +22,7 @@ import (
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/cli"
 	app "github.com/wirelineio/registry"
+	adminclient "github.com/wirelineio/registry/x/admin/client"
 	htlcclient "github.com/wirelineio/registry/x/htlc/client"
 	msigclient "github.com/wirelineio/registry/x/multisig/client"
 	regclient "github.com/wirelineio/registry/x/registry/client"
@@ -48,6 +49,7 @@ func main() {
 	config.Seal()
 
 	mc := []sdk.ModuleClients{
+		adminclient.NewModuleClient(cdc),
 		htlcclient.NewModuleClient(cdc),
 		msigclient.NewModuleClient(storeMultisig, cdc),
 		utxoclient.NewModuleClient(cdc),
@@ -36,6 +36,7 @@ var DefaultNodeHome = os.ExpandEnv("$HOME/.registryd")
 
 const (
 	flagOverwrite = "overwrite"
+	flagDevMode   = "dev-mode"
 )
 
 func main() {
@@ -52,6 +53,7 @@ func main() {
 
 	rootCmd.AddCommand(InitCmd(ctx, cdc))
 	rootCmd.AddCommand(AddGenesisAccountCmd(ctx, cdc))
+	rootCmd.AddCommand(ValidateGenesisCmd(ctx, cdc))
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
@@ -106,7 +108,10 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("genesis.json file already exists: %v", genFile)
 			}
 
-			appState, err = codec.MarshalJSONIndent(cdc, app.GenesisState{})
+			genesisState := app.NewDefaultGenesisState()
+			genesisState.Admin.DevMode = viper.GetBool(flagDevMode)
+
+			appState, err = codec.MarshalJSONIndent(cdc, genesisState)
 			if err != nil {
 				return err
 			}
@@ -121,6 +126,8 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// Index the tx tags emitted by the module handlers (see README), so txs can be queried by tag.
+			config.TxIndex.IndexAllTags = true
 			cfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
 
 			fmt.Printf("Initialized registryd configuration and bootstrapping files in %s...\n", viper.GetString(cli.HomeFlag))
@@ -131,6 +138,7 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.HomeFlag, DefaultNodeHome, "node's home directory")
 	cmd.Flags().String(client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite the genesis.json file")
+	cmd.Flags().Bool(flagDevMode, false, "allow dev-only messages (e.g. clearing records) from any signer")
 
 	return cmd
 }
@@ -195,3 +203,36 @@ $ registryd add-genesis-account cosmos1tse7r2fadvlrrgau3pa0ss7cqh55wrv6y9alwh 10
 	}
 	return cmd
 }
+
+// ValidateGenesisCmd checks that a genesis file is valid, e.g. before restarting a chain from an export.
+func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Short: "Validates the genesis file at the default location or at the given path",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			genFile := ctx.Config.GenesisFile()
+			if len(args) == 1 {
+				genFile = args[0]
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(genFile)
+			if err != nil {
+				return fmt.Errorf("error loading genesis doc from %s: %s", genFile, err)
+			}
+
+			var appState app.GenesisState
+			if err = cdc.UnmarshalJSON(genDoc.AppState, &appState); err != nil {
+				return fmt.Errorf("error unmarshalling genesis app state: %s", err)
+			}
+
+			if err = app.ValidateGenesisState(appState); err != nil {
+				return err
+			}
+
+			fmt.Printf("File at %s is a valid genesis file for registryd\n", genFile)
+
+			return nil
+		},
+	}
+}
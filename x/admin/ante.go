@@ -0,0 +1,53 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package admin
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAnteHandler returns an AnteHandler that rejects dev-only messages (matched by route and type) unless the chain
+// is in dev mode or all their signers are admins, before calling the next AnteHandler.
+func NewAnteHandler(keeper Keeper, next sdk.AnteHandler, devOnlyMsgs ...sdk.Msg) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		for _, msg := range tx.GetMsgs() {
+			if !isDevOnlyMsg(msg, devOnlyMsgs) {
+				continue
+			}
+
+			if err := checkDevOnlyMsg(keeper.GetConfig(ctx), msg); err != nil {
+				return ctx, err.Result(), true
+			}
+		}
+
+		return next(ctx, tx, simulate)
+	}
+}
+
+func isDevOnlyMsg(msg sdk.Msg, devOnlyMsgs []sdk.Msg) bool {
+	for _, devOnlyMsg := range devOnlyMsgs {
+		if msg.Route() == devOnlyMsg.Route() && msg.Type() == devOnlyMsg.Type() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkDevOnlyMsg(config Config, msg sdk.Msg) sdk.Error {
+	if config.DevMode {
+		return nil
+	}
+
+	for _, signer := range msg.GetSigners() {
+		if !config.IsAdmin(signer) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("Message %s/%s is only allowed on dev networks or by admins.", msg.Route(), msg.Type()))
+		}
+	}
+
+	return nil
+}
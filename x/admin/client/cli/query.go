@@ -0,0 +1,60 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wirelineio/registry/x/admin"
+)
+
+// GetCmdConfig queries the admin config (dev mode and admins).
+func GetCmdConfig(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "config",
+		Short: "Get admin config (dev mode and admins).",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, admin.QueryConfig), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+// EnsureDevMode returns an error unless the node reports dev mode, or the signer is an admin.
+// Used by commands that send dev-only messages, which are rejected by the chain otherwise.
+func EnsureDevMode(cliCtx context.CLIContext, queryRoute string, signer sdk.AccAddress) error {
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, admin.QueryConfig), nil)
+	if err != nil {
+		return err
+	}
+
+	var config admin.Config
+	if err := cliCtx.Codec.UnmarshalJSON(res, &config); err != nil {
+		return err
+	}
+
+	if !config.DevMode && !config.IsAdmin(signer) {
+		return errors.New("refusing to send a dev-only message: the node isn't in dev mode and the signer isn't an admin")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+	admincmd "github.com/wirelineio/registry/x/admin/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module.
+type ModuleClient struct {
+	cdc *amino.Codec
+}
+
+// NewModuleClient is the constructor for the module client.
+func NewModuleClient(cdc *amino.Codec) ModuleClient {
+	return ModuleClient{cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module.
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	// Group admin queries under a subcommand
+	adminQueryCmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Querying commands for the admin module",
+	}
+
+	adminQueryCmd.AddCommand(client.GetCommands(
+		admincmd.GetCmdConfig("admin", mc.cdc),
+	)...)
+
+	return adminQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module.
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	adminTxCmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Admin transactions subcommands",
+	}
+
+	adminTxCmd.AddCommand(client.PostCommands()...)
+
+	return adminTxCmd
+}
@@ -0,0 +1,55 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package admin
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState represents the admin settings at the start of the chain.
+type GenesisState struct {
+	DevMode bool             `json:"dev_mode"`
+	Admins  []sdk.AccAddress `json:"admins"`
+}
+
+// DefaultGenesisState returns the admin genesis state of a production network (no dev mode, no admins).
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Admins: []sdk.AccAddress{}}
+}
+
+// InitGenesis imports the admin settings from genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetConfig(ctx, Config{DevMode: data.DevMode, Admins: data.Admins})
+}
+
+// ExportGenesis exports the admin settings.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	config := keeper.GetConfig(ctx)
+
+	data := DefaultGenesisState()
+	data.DevMode = config.DevMode
+	data.Admins = append(data.Admins, config.Admins...)
+
+	return data
+}
+
+// ValidateGenesis checks that the admin genesis state is consistent.
+func ValidateGenesis(data GenesisState) error {
+	admins := make(map[string]bool)
+	for _, admin := range data.Admins {
+		if admin.Empty() {
+			return fmt.Errorf("empty admin address")
+		}
+
+		if admins[admin.String()] {
+			return fmt.Errorf("duplicate admin %s", admin)
+		}
+		admins[admin.String()] = true
+	}
+
+	return nil
+}
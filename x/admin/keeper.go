@@ -0,0 +1,65 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package admin
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var configKey = []byte("config")
+
+// Config represents the chain-level admin settings.
+type Config struct {
+	// Dev networks allow any signer to send dev-only messages (e.g. clearing module state).
+	DevMode bool `json:"dev_mode"`
+	// Admins are allowed to send dev-only messages on any network.
+	Admins []sdk.AccAddress `json:"admins"`
+}
+
+// IsAdmin checks if the address is in the admin list.
+func (config Config) IsAdmin(address sdk.AccAddress) bool {
+	for _, admin := range config.Admins {
+		if admin.Equals(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine.
+type Keeper struct {
+	adminStoreKey sdk.StoreKey // Unexposed key to access admin store from sdk.Context.
+
+	cdc *codec.Codec // The wire codec for binary encoding/decoding.
+}
+
+// NewKeeper creates new instances of the admin Keeper.
+func NewKeeper(adminStoreKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{
+		adminStoreKey: adminStoreKey,
+		cdc:           cdc,
+	}
+}
+
+// SetConfig - saves the admin config.
+func (k Keeper) SetConfig(ctx sdk.Context, config Config) {
+	store := ctx.KVStore(k.adminStoreKey)
+	// Length prefixed, as the bare encoding of the default config is empty (which the store doesn't allow).
+	store.Set(configKey, k.cdc.MustMarshalBinaryLengthPrefixed(config))
+}
+
+// GetConfig - gets the admin config (dev mode is off, with no admins, if not set).
+func (k Keeper) GetConfig(ctx sdk.Context) Config {
+	store := ctx.KVStore(k.adminStoreKey)
+
+	var config Config
+	if bz := store.Get(configKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &config)
+	}
+
+	return config
+}
@@ -0,0 +1,38 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package admin
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Endpoints supported by the Querier.
+const (
+	QueryConfig = "config"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryConfig:
+			return queryConfig(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("Unknown admin query endpoint.")
+		}
+	}
+}
+
+// nolint: unparam
+func queryConfig(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, keeper.GetConfig(ctx))
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
+	}
+
+	return bz, nil
+}
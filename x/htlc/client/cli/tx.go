@@ -16,6 +16,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 	"github.com/spf13/cobra"
+	regutils "github.com/wirelineio/registry/client/utils"
+	admincli "github.com/wirelineio/registry/x/admin/client/cli"
 	"github.com/wirelineio/registry/x/htlc"
 )
 
@@ -68,7 +70,7 @@ func GetCmdAddHtlc(cdc *codec.Codec) *cobra.Command {
 				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
 			}
 
-			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 }
@@ -109,7 +111,7 @@ func GetCmdRedeemHtlc(cdc *codec.Codec) *cobra.Command {
 				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
 			}
 
-			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 }
@@ -148,12 +150,13 @@ func GetCmdFailHtlc(cdc *codec.Codec) *cobra.Command {
 				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
 			}
 
-			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 }
 
 // GetCmdClearHtlc is the CLI command for sending a ClearHtlc transaction.
+// NOTE: Only allowed on dev networks (or for admins).
 func GetCmdClearHtlc(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "clear",
@@ -173,6 +176,10 @@ func GetCmdClearHtlc(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			if err := admincli.EnsureDevMode(cliCtx, "admin", senderAccount); err != nil {
+				return err
+			}
+
 			msg := htlc.NewMsgClearHtlc(senderAccount)
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -185,7 +192,7 @@ func GetCmdClearHtlc(cdc *codec.Codec) *cobra.Command {
 				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
 			}
 
-			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 }
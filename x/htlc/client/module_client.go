@@ -29,7 +29,9 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		Short: "Querying commands for the htlc module",
 	}
 
-	htlcQueryCmd.AddCommand(client.GetCommands()...)
+	htlcQueryCmd.AddCommand(client.GetCommands(
+		htlccmd.GetCmdParams("htlc", mc.cdc),
+	)...)
 
 	return htlcQueryCmd
 }
@@ -0,0 +1,99 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package htlc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState represents the HTLC state at the start of the chain.
+// HTLC creation heights are relative to the genesis height (i.e. zero or negative), so that timeouts are kept when a
+// chain is restarted from an export (at height 1).
+type GenesisState struct {
+	Params Params    `json:"params"`
+	Htlcs  []ObjHtlc `json:"htlcs"`
+}
+
+// DefaultGenesisState returns an HTLC genesis state with the default params, and no HTLCs.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams(), Htlcs: []ObjHtlc{}}
+}
+
+// InitGenesis imports the HTLC state from genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, obj := range data.Htlcs {
+		obj.BlockCreatedAt += ctx.BlockHeight()
+		keeper.UpsertHtlc(ctx, obj)
+	}
+}
+
+// ExportGenesis exports the HTLC state, in hash order, with creation heights relative to the export height.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	data := DefaultGenesisState()
+	data.Params = keeper.GetParams(ctx)
+
+	keeper.IterateHtlcs(ctx, func(obj ObjHtlc) bool {
+		obj.BlockCreatedAt -= ctx.BlockHeight()
+		data.Htlcs = append(data.Htlcs, obj)
+		return false
+	})
+
+	return data
+}
+
+// ValidateGenesis checks that the HTLC genesis state is consistent.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %s", err)
+	}
+
+	hashes := make(map[string]bool)
+	for _, obj := range data.Htlcs {
+		if obj.Hash == "" {
+			return fmt.Errorf("HTLC has no hash")
+		}
+
+		if hashes[obj.Hash] {
+			return fmt.Errorf("duplicate HTLC %s", obj.Hash)
+		}
+		hashes[obj.Hash] = true
+
+		if !obj.Amount.IsPositive() {
+			return fmt.Errorf("HTLC %s amount must be positive", obj.Hash)
+		}
+
+		if obj.RedeemAddress.Empty() || obj.TimeoutAddress.Empty() {
+			return fmt.Errorf("HTLC %s must have redeem and timeout addresses", obj.Hash)
+		}
+
+		if obj.BlockCreatedAt > 0 {
+			return fmt.Errorf("HTLC %s creation height must be relative to genesis (zero or negative)", obj.Hash)
+		}
+
+		switch obj.Status {
+		case HtlcCreated, HtlcRedeemed, HtlcFailed:
+		default:
+			return fmt.Errorf("HTLC %s has an invalid status %d", obj.Hash, obj.Status)
+		}
+	}
+
+	return nil
+}
+
+// GetEscrowedCoins returns the coins locked in HTLCs (that haven't been redeemed or failed) in the genesis state.
+func (data GenesisState) GetEscrowedCoins() sdk.Coins {
+	escrow := sdk.Coins{}
+	for _, obj := range data.Htlcs {
+		if obj.Status == HtlcCreated {
+			escrow = escrow.Plus(sdk.Coins{obj.Amount})
+		}
+	}
+
+	return escrow
+}
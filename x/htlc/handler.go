@@ -57,6 +57,15 @@ func handleMsgAddHtlc(ctx sdk.Context, keeper Keeper, msg MsgAddHtlc) sdk.Result
 		return sdk.ErrInternal("HTLC by that hash already exists.").Result()
 	}
 
+	params := keeper.GetParams(ctx)
+	if params.MaxLocktime > 0 && msg.Locktime > params.MaxLocktime {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Locktime exceeds the max of %d blocks.", params.MaxLocktime)).Result()
+	}
+
+	if !params.IsDenomAllowed(msg.Amount.Denom) {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("HTLCs can't lock %s.", msg.Amount.Denom)).Result()
+	}
+
 	_, _, err := keeper.coinKeeper.SubtractCoins(ctx, msg.TimeoutAddress, sdk.Coins{msg.Amount})
 	if err != nil {
 		return sdk.ErrInsufficientCoins("Not enough coins to create HTLC.").Result()
@@ -74,7 +83,7 @@ func handleMsgAddHtlc(ctx sdk.Context, keeper Keeper, msg MsgAddHtlc) sdk.Result
 
 	keeper.UpsertHtlc(ctx, obj)
 
-	return sdk.Result{}
+	return sdk.Result{Tags: getHtlcTags(ActionAddHtlc, obj)}
 }
 
 // Handle MsgRedeemHtlc
@@ -108,7 +117,7 @@ func handleMsgRedeemHtlc(ctx sdk.Context, keeper Keeper, msg MsgRedeemHtlc) sdk.
 		return sdk.ErrInsufficientCoins("Error redeeming HTLC.").Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{Tags: getHtlcTags(ActionRedeemHtlc, obj)}
 }
 
 // Handle MsgFailHtlc
@@ -139,12 +148,29 @@ func handleMsgFailHtlc(ctx sdk.Context, keeper Keeper, msg MsgFailHtlc) sdk.Resu
 		return sdk.ErrInsufficientCoins("Error timing out HTLC.").Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{Tags: getHtlcTags(ActionFailHtlc, obj)}
 }
 
 // Handle MsgClearHtlc
+// Amounts locked in HTLCs that haven't been redeemed or timed out are returned to their timeout accounts, so that
+// clearing the store doesn't burn coins.
 func handleMsgClearHtlc(ctx sdk.Context, keeper Keeper, msg MsgClearHtlc) sdk.Result {
+	var locked []ObjHtlc
+	keeper.IterateHtlcs(ctx, func(obj ObjHtlc) bool {
+		if obj.Status == HtlcCreated {
+			locked = append(locked, obj)
+		}
+		return false
+	})
+
+	for _, obj := range locked {
+		_, _, err := keeper.coinKeeper.AddCoins(ctx, obj.TimeoutAddress, sdk.Coins{obj.Amount})
+		if err != nil {
+			return sdk.ErrInsufficientCoins("Error returning HTLC amount.").Result()
+		}
+	}
+
 	keeper.Clear(ctx)
 
-	return sdk.Result{}
+	return sdk.Result{Tags: sdk.NewTags(TagAction, []byte(ActionClearHtlc), TagSigner, []byte(msg.Sender.String()))}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine.
@@ -16,14 +17,17 @@ type Keeper struct {
 
 	htlcStoreKey sdk.StoreKey // Unexposed key to access HTLC store from sdk.Context.
 
+	paramstore params.Subspace
+
 	cdc *codec.Codec // The wire codec for binary encoding/decoding.
 }
 
 // NewKeeper creates new instances of the HTLC Keeper.
-func NewKeeper(coinKeeper bank.Keeper, htlcStoreKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+func NewKeeper(coinKeeper bank.Keeper, htlcStoreKey sdk.StoreKey, paramstore params.Subspace, cdc *codec.Codec) Keeper {
 	return Keeper{
 		coinKeeper:   coinKeeper,
 		htlcStoreKey: htlcStoreKey,
+		paramstore:   paramstore.WithTypeTable(ParamTypeTable()),
 		cdc:          cdc,
 	}
 }
@@ -52,6 +56,20 @@ func (k Keeper) GetHtlc(ctx sdk.Context, hash string) ObjHtlc {
 	return obj
 }
 
+// IterateHtlcs - iterates over HTLCs, in hash order, until the handler returns true.
+func (k Keeper) IterateHtlcs(ctx sdk.Context, handler func(obj ObjHtlc) (stop bool)) {
+	store := ctx.KVStore(k.htlcStoreKey)
+	itr := store.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var obj ObjHtlc
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &obj)
+		if handler(obj) {
+			break
+		}
+	}
+}
+
 // Clear - clear all entries from the store [TESTING ONLY!].
 func (k Keeper) Clear(ctx sdk.Context) {
 	store := ctx.KVStore(k.htlcStoreKey)
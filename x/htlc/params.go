@@ -0,0 +1,96 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package htlc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// DefaultParamspace is the param subspace of the HTLC module.
+const DefaultParamspace = "htlc"
+
+// DefaultMaxLocktime is the default max HTLC locktime, in blocks (about a week, at 5 second blocks).
+const DefaultMaxLocktime int64 = 120960
+
+// Keys for HTLC params.
+var (
+	KeyMaxLocktime   = []byte("MaxLocktime")
+	KeyAllowedDenoms = []byte("AllowedDenoms")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params defines the HTLC limits.
+type Params struct {
+	// Max locktime, in blocks (0 for no limit).
+	MaxLocktime int64 `json:"max_locktime"`
+	// Denominations that can be locked in HTLCs (any denomination is allowed if empty).
+	AllowedDenoms []string `json:"allowed_denoms"`
+}
+
+// KeyValuePairs implements params.ParamSet.
+func (p *Params) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{Key: KeyMaxLocktime, Value: &p.MaxLocktime},
+		{Key: KeyAllowedDenoms, Value: &p.AllowedDenoms},
+	}
+}
+
+// ParamTypeTable returns the param type table of the HTLC module.
+func ParamTypeTable() params.TypeTable {
+	return params.NewTypeTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default HTLC params.
+func DefaultParams() Params {
+	return Params{
+		MaxLocktime:   DefaultMaxLocktime,
+		AllowedDenoms: []string{},
+	}
+}
+
+// Validate checks that the params are well formed.
+func (p Params) Validate() error {
+	if p.MaxLocktime < 0 {
+		return fmt.Errorf("max locktime must not be negative")
+	}
+
+	for _, denom := range p.AllowedDenoms {
+		if denom == "" {
+			return fmt.Errorf("allowed denominations must not be empty")
+		}
+	}
+
+	return nil
+}
+
+// IsDenomAllowed checks if coins of the given denomination can be locked in HTLCs.
+func (p Params) IsDenomAllowed(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDenoms {
+		if denom == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetParams - gets the HTLC params.
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams - saves the HTLC params.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,38 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package htlc
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Endpoints supported by the Querier.
+const (
+	QueryParams = "params"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("Unknown htlc query endpoint.")
+		}
+	}
+}
+
+// nolint: unparam
+func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
+	}
+
+	return bz, nil
+}
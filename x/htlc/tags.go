@@ -0,0 +1,36 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package htlc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tag keys added to the results of HTLC txs, used to index and query txs (e.g. `regcli query txs --tags`).
+const (
+	TagAction         = "action" // Same as sdk.TagAction.
+	TagHash           = "htlc-hash"
+	TagRedeemAddress  = "redeem-address"
+	TagTimeoutAddress = "timeout-address"
+	TagSigner         = "signer"
+)
+
+// Values of the action tag.
+const (
+	ActionAddHtlc    = "add-htlc"
+	ActionRedeemHtlc = "redeem-htlc"
+	ActionFailHtlc   = "fail-htlc"
+	ActionClearHtlc  = "clear-htlc"
+)
+
+// getHtlcTags returns the tags for an action on an HTLC.
+func getHtlcTags(action string, obj ObjHtlc) sdk.Tags {
+	return sdk.NewTags(
+		TagAction, []byte(action),
+		TagHash, []byte(obj.Hash),
+		TagRedeemAddress, []byte(obj.RedeemAddress.String()),
+		TagTimeoutAddress, []byte(obj.TimeoutAddress.String()),
+	)
+}
@@ -33,6 +33,7 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 
 	multisigQueryCmd.AddCommand(client.GetCommands(
 		multisigqry.GetCmdView(mc.storeKey, mc.cdc),
+		multisigqry.GetCmdParams(mc.storeKey, mc.cdc),
 	)...)
 
 	return multisigQueryCmd
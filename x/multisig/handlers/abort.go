@@ -32,5 +32,5 @@ func handleMsgAbortMultiSig(ctx sdk.Context, keeper Keeper, msg msgs.MsgAbortMul
 
 	keeper.DeleteContract(ctx, msg.ID)
 
-	return sdk.Result{}
+	return sdk.Result{Tags: getContractTags(ActionAbortMultiSig, obj)}
 }
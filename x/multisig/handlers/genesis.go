@@ -0,0 +1,89 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState represents the multisig state at the start of the chain.
+type GenesisState struct {
+	Params    Params     `json:"params"`
+	Contracts []Contract `json:"contracts"`
+}
+
+// DefaultGenesisState returns a multisig genesis state with the default params, and no contracts.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams(), Contracts: []Contract{}}
+}
+
+// InitGenesis imports the multisig state from genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, obj := range data.Contracts {
+		keeper.UpsertContract(ctx, obj)
+	}
+}
+
+// ExportGenesis exports the multisig state, in contract ID order.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	data := DefaultGenesisState()
+	data.Params = keeper.GetParams(ctx)
+
+	keeper.IterateContracts(ctx, func(obj Contract) bool {
+		data.Contracts = append(data.Contracts, obj)
+		return false
+	})
+
+	return data
+}
+
+// ValidateGenesis checks that the multisig genesis state is consistent.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %s", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, obj := range data.Contracts {
+		if obj.ID == "" {
+			return fmt.Errorf("contract has no ID")
+		}
+
+		if ids[obj.ID] {
+			return fmt.Errorf("duplicate contract %s", obj.ID)
+		}
+		ids[obj.ID] = true
+
+		if obj.State != StateCreated && obj.State != StateLocked {
+			return fmt.Errorf("contract %s has an invalid state %d", obj.ID, obj.State)
+		}
+
+		if obj.AliceAddress.Empty() || obj.BobAddress.Empty() {
+			return fmt.Errorf("contract %s must have both addresses", obj.ID)
+		}
+
+		if !obj.Balance.IsNotNegative() {
+			return fmt.Errorf("contract %s has a negative balance", obj.ID)
+		}
+	}
+
+	return nil
+}
+
+// GetEscrowedCoins returns the coins held by contracts in the genesis state.
+func (data GenesisState) GetEscrowedCoins() sdk.Coins {
+	escrow := sdk.Coins{}
+	for _, obj := range data.Contracts {
+		if obj.Balance.IsPositive() {
+			escrow = escrow.Plus(sdk.Coins{obj.Balance})
+		}
+	}
+
+	return escrow
+}
@@ -5,6 +5,8 @@
 package handlers
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/wirelineio/registry/x/multisig/msgs"
 )
@@ -32,6 +34,15 @@ func handleMsgInitMultiSig(ctx sdk.Context, keeper Keeper, msg msgs.MsgInitMulti
 		return sdk.ErrInternal("Amount denomination mismatch.").Result()
 	}
 
+	params := keeper.GetParams(ctx)
+	if params.MaxIDLength > 0 && int64(len(msg.ID)) > params.MaxIDLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Contract ID exceeds the max length of %d.", params.MaxIDLength)).Result()
+	}
+
+	if !params.IsDenomAllowed(msg.AliceAmount.Denom) {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("Contracts can't hold %s.", msg.AliceAmount.Denom)).Result()
+	}
+
 	_, _, err := keeper.coinKeeper.SubtractCoins(ctx, msg.AliceAddress, sdk.Coins{msg.AliceAmount})
 	if err != nil {
 		return sdk.ErrInsufficientCoins("Not enough coins.").Result()
@@ -49,5 +60,5 @@ func handleMsgInitMultiSig(ctx sdk.Context, keeper Keeper, msg msgs.MsgInitMulti
 
 	keeper.UpsertContract(ctx, obj)
 
-	return sdk.Result{}
+	return sdk.Result{Tags: getContractTags(ActionInitMultiSig, obj)}
 }
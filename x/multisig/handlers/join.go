@@ -38,5 +38,5 @@ func handleMsgJoinMultiSig(ctx sdk.Context, keeper Keeper, msg msgs.MsgJoinMulti
 	obj.Balance = obj.Balance.Plus(obj.BobAmount)
 	keeper.UpsertContract(ctx, obj)
 
-	return sdk.Result{}
+	return sdk.Result{Tags: getContractTags(ActionJoinMultiSig, obj)}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // State of the contract.
@@ -25,6 +26,8 @@ type Keeper struct {
 
 	multisigStoreKey sdk.StoreKey // Unexposed key to access HTLC store from sdk.Context.
 
+	paramstore params.Subspace
+
 	cdc *codec.Codec // The wire codec for binary encoding/decoding.
 }
 
@@ -40,10 +43,11 @@ type Contract struct {
 }
 
 // NewKeeper creates new instances of the multisig Keeper.
-func NewKeeper(coinKeeper bank.Keeper, multisigStoreKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+func NewKeeper(coinKeeper bank.Keeper, multisigStoreKey sdk.StoreKey, paramstore params.Subspace, cdc *codec.Codec) Keeper {
 	return Keeper{
 		coinKeeper:       coinKeeper,
 		multisigStoreKey: multisigStoreKey,
+		paramstore:       paramstore.WithTypeTable(ParamTypeTable()),
 		cdc:              cdc,
 	}
 }
@@ -77,3 +81,17 @@ func (k Keeper) DeleteContract(ctx sdk.Context, id string) {
 	store := ctx.KVStore(k.multisigStoreKey)
 	store.Delete([]byte(id))
 }
+
+// IterateContracts - iterates over contracts, in ID order, until the handler returns true.
+func (k Keeper) IterateContracts(ctx sdk.Context, handler func(obj Contract) (stop bool)) {
+	store := ctx.KVStore(k.multisigStoreKey)
+	itr := store.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var obj Contract
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &obj)
+		if handler(obj) {
+			break
+		}
+	}
+}
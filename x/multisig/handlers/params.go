@@ -0,0 +1,96 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// DefaultParamspace is the param subspace of the multisig module.
+const DefaultParamspace = "multisig"
+
+// DefaultMaxIDLength is the default max length of contract IDs.
+const DefaultMaxIDLength int64 = 128
+
+// Keys for multisig params.
+var (
+	KeyMaxIDLength   = []byte("MaxIDLength")
+	KeyAllowedDenoms = []byte("AllowedDenoms")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params defines the multisig limits.
+type Params struct {
+	// Max length of contract IDs (0 for no limit).
+	MaxIDLength int64 `json:"max_id_length"`
+	// Denominations that can be held by contracts (any denomination is allowed if empty).
+	AllowedDenoms []string `json:"allowed_denoms"`
+}
+
+// KeyValuePairs implements params.ParamSet.
+func (p *Params) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{Key: KeyMaxIDLength, Value: &p.MaxIDLength},
+		{Key: KeyAllowedDenoms, Value: &p.AllowedDenoms},
+	}
+}
+
+// ParamTypeTable returns the param type table of the multisig module.
+func ParamTypeTable() params.TypeTable {
+	return params.NewTypeTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default multisig params.
+func DefaultParams() Params {
+	return Params{
+		MaxIDLength:   DefaultMaxIDLength,
+		AllowedDenoms: []string{},
+	}
+}
+
+// Validate checks that the params are well formed.
+func (p Params) Validate() error {
+	if p.MaxIDLength < 0 {
+		return fmt.Errorf("max ID length must not be negative")
+	}
+
+	for _, denom := range p.AllowedDenoms {
+		if denom == "" {
+			return fmt.Errorf("allowed denominations must not be empty")
+		}
+	}
+
+	return nil
+}
+
+// IsDenomAllowed checks if coins of the given denomination can be held by contracts.
+func (p Params) IsDenomAllowed(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDenoms {
+		if denom == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetParams - gets the multisig params.
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams - saves the multisig params.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
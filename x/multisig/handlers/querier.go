@@ -12,7 +12,8 @@ import (
 
 // Endpoints supported by the Querier.
 const (
-	QueryView = "view"
+	QueryView   = "view"
+	QueryParams = "params"
 )
 
 // NewQuerier is the module level router for state queries
@@ -21,6 +22,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryView:
 			return queryView(ctx, path[1:], req, keeper)
+		case QueryParams:
+			return queryParams(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown multisig query endpoint.")
 		}
@@ -44,3 +47,13 @@ func queryView(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 
 	return bz, nil
 }
+
+// nolint: unparam
+func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
+	}
+
+	return bz, nil
+}
@@ -36,5 +36,7 @@ func handleMsgSpendMultiSig(ctx sdk.Context, keeper Keeper, msg msgs.MsgSpendMul
 	obj.Balance = obj.Balance.Minus(msg.Amount)
 	keeper.UpsertContract(ctx, obj)
 
-	return sdk.Result{}
+	tags := getContractTags(ActionSpendMultiSig, obj).AppendTag(TagToAddress, []byte(msg.ToAddress.String()))
+
+	return sdk.Result{Tags: tags}
 }
@@ -0,0 +1,36 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tag keys added to the results of multisig txs, used to index and query txs (e.g. `regcli query txs --tags`).
+const (
+	TagAction       = "action" // Same as sdk.TagAction.
+	TagContractID   = "contract-id"
+	TagAliceAddress = "alice-address"
+	TagBobAddress   = "bob-address"
+	TagToAddress    = "to-address"
+)
+
+// Values of the action tag.
+const (
+	ActionInitMultiSig  = "init-multisig"
+	ActionJoinMultiSig  = "join-multisig"
+	ActionAbortMultiSig = "abort-multisig"
+	ActionSpendMultiSig = "spend-multisig"
+)
+
+// getContractTags returns the tags for an action on a contract.
+func getContractTags(action string, obj Contract) sdk.Tags {
+	return sdk.NewTags(
+		TagAction, []byte(action),
+		TagContractID, []byte(obj.ID),
+		TagAliceAddress, []byte(obj.AliceAddress.String()),
+		TagBobAddress, []byte(obj.BobAddress.String()),
+	)
+}
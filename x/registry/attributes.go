@@ -0,0 +1,258 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Attribute values are stored as JSON, with conventions that keep their types distinct:
+// - Integers (int64) are written without a fraction or exponent, floats (float64) always have one (e.g. 3.0).
+// - Bytes are written as {"/": {"bytes": "<base64>"}}.
+// - Strings, booleans, null, lists and nested maps are plain JSON.
+//
+// Decoded attribute values are one of: nil, bool, int64, float64, string, []byte, []interface{}, map[string]interface{}.
+
+// BytesAttributeKey is the key of the wrapper object used to encode bytes attribute values.
+const BytesAttributeKey = "/"
+
+// MarshalAttributes encodes attributes as JSON, preserving value types.
+func MarshalAttributes(attributes map[string]interface{}) ([]byte, error) {
+	if attributes == nil {
+		return []byte("null"), nil
+	}
+
+	var buffer bytes.Buffer
+	if err := writeAttributeValue(&buffer, attributes); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// UnMarshalAttributes decodes attributes encoded by MarshalAttributes (or plain JSON).
+func UnMarshalAttributes(bz []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var attributes map[string]interface{}
+	if err := decoder.Decode(&attributes); err != nil {
+		return nil, err
+	}
+
+	for key, value := range attributes {
+		typed, err := toTypedAttributeValue(value)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[key] = typed
+	}
+
+	return attributes, nil
+}
+
+// UnMarshalAttributeValue decodes a single attribute value encoded as JSON.
+func UnMarshalAttributeValue(bz []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	return toTypedAttributeValue(generic)
+}
+
+// NormalizeAttributeValue converts Go values (e.g. int, []string) to the types used for decoded attribute values.
+func NormalizeAttributeValue(value interface{}) (interface{}, error) {
+	var buffer bytes.Buffer
+	if err := writeAttributeValue(&buffer, value); err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(&buffer)
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	return toTypedAttributeValue(generic)
+}
+
+// attributeValuesEqual checks if two attribute values are equal, including their types (e.g. 3 doesn't equal 3.0).
+// Values are compared by their typed encoding, which is also used by the attribute index.
+func attributeValuesEqual(a interface{}, b interface{}) bool {
+	var bufferA, bufferB bytes.Buffer
+	if writeAttributeValue(&bufferA, a) != nil || writeAttributeValue(&bufferB, b) != nil {
+		return false
+	}
+
+	return bytes.Equal(bufferA.Bytes(), bufferB.Bytes())
+}
+
+func writeAttributeValue(buffer *bytes.Buffer, value interface{}) error {
+	switch val := value.(type) {
+	case nil, bool, string, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+		bz, err := json.Marshal(val)
+		if err != nil {
+			return err
+		}
+
+		buffer.Write(bz)
+	case float32:
+		return writeFloat(buffer, float64(val))
+	case float64:
+		return writeFloat(buffer, val)
+	case []byte:
+		return writeAttributeValue(buffer, map[string]interface{}{
+			BytesAttributeKey: map[string]interface{}{"bytes": base64.StdEncoding.EncodeToString(val)},
+		})
+	case []interface{}:
+		buffer.WriteByte('[')
+		for index, item := range val {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+
+			if err := writeAttributeValue(buffer, item); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		buffer.WriteByte('{')
+		for index, key := range keys {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+
+			bz, err := json.Marshal(key)
+			if err != nil {
+				return err
+			}
+
+			buffer.Write(bz)
+			buffer.WriteByte(':')
+
+			if err := writeAttributeValue(buffer, val[key]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	default:
+		// Other slices and maps (e.g. []string), via their plain JSON encoding.
+		bz, err := json.Marshal(val)
+		if err != nil {
+			return err
+		}
+
+		// Decode numbers as json.Number, so that integers (e.g. in []int) aren't written as floats.
+		decoder := json.NewDecoder(bytes.NewReader(bz))
+		decoder.UseNumber()
+
+		var generic interface{}
+		if err := decoder.Decode(&generic); err != nil {
+			return err
+		}
+
+		return writeAttributeValue(buffer, generic)
+	}
+
+	return nil
+}
+
+// Floats always have a fraction or exponent, so that they aren't decoded as integers.
+func writeFloat(buffer *bytes.Buffer, val float64) error {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return fmt.Errorf("unsupported attribute value %v", val)
+	}
+
+	str := strconv.FormatFloat(val, 'g', -1, 64)
+	if !strings.ContainsAny(str, ".e") {
+		str += ".0"
+	}
+
+	buffer.WriteString(str)
+
+	return nil
+}
+
+func toTypedAttributeValue(value interface{}) (interface{}, error) {
+	switch val := value.(type) {
+	case json.Number:
+		str := val.String()
+		// Integers must fit in an int64, rather than being rounded to a float (see canonicalNumber).
+		if !strings.ContainsAny(str, ".eE") {
+			num, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("integer %s out of range", str)
+			}
+
+			return num, nil
+		}
+
+		return val.Float64()
+	case []interface{}:
+		for index, item := range val {
+			typed, err := toTypedAttributeValue(item)
+			if err != nil {
+				return nil, err
+			}
+
+			val[index] = typed
+		}
+
+		return val, nil
+	case map[string]interface{}:
+		if bz, ok := getBytesAttributeValue(val); ok {
+			return base64.StdEncoding.DecodeString(bz)
+		}
+
+		for key, item := range val {
+			typed, err := toTypedAttributeValue(item)
+			if err != nil {
+				return nil, err
+			}
+
+			val[key] = typed
+		}
+
+		return val, nil
+	}
+
+	return value, nil
+}
+
+func getBytesAttributeValue(val map[string]interface{}) (string, bool) {
+	if len(val) != 1 {
+		return "", false
+	}
+
+	wrapper, ok := val[BytesAttributeKey].(map[string]interface{})
+	if !ok || len(wrapper) != 1 {
+		return "", false
+	}
+
+	bz, ok := wrapper["bytes"].(string)
+
+	return bz, ok
+}
@@ -0,0 +1,195 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAttributesRoundTrip(t *testing.T) {
+	attributes := map[string]interface{}{
+		"int":      int64(3),
+		"maxInt":   int64(math.MaxInt64),
+		"minInt":   int64(math.MinInt64),
+		"float":    0.5,
+		"whole":    3.0,
+		"big":      1e21,
+		"string":   "weather",
+		"bool":     true,
+		"null":     nil,
+		"bytes":    []byte{1, 2, 3},
+		"list":     []interface{}{int64(1), 2.0, "x", nil, []byte{4}},
+		"map":      map[string]interface{}{"a": int64(1), "b": map[string]interface{}{"c": []interface{}{false}}},
+		"emptyMap": map[string]interface{}{},
+	}
+
+	bz, err := MarshalAttributes(attributes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := UnMarshalAttributes(bz)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(decoded, attributes) {
+		t.Errorf("UnMarshalAttributes(%s) = %#v, want %#v", bz, decoded, attributes)
+	}
+
+	// The encoding is deterministic, so that it can be indexed and compared.
+	again, err := MarshalAttributes(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(again) != string(bz) {
+		t.Errorf("MarshalAttributes = %s, want %s", again, bz)
+	}
+}
+
+func TestMarshalAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		want       string
+		err        bool
+	}{
+		{"nil", nil, "null", false},
+		{"empty", map[string]interface{}{}, "{}", false},
+		{"sorted keys", map[string]interface{}{"b": 1, "a": 2}, `{"a":2,"b":1}`, false},
+		{"whole float", map[string]interface{}{"a": 3.0}, `{"a":3.0}`, false},
+		{"float32", map[string]interface{}{"a": float32(0.5)}, `{"a":0.5}`, false},
+		{"bytes", map[string]interface{}{"a": []byte{1, 2, 3}}, `{"a":{"/":{"bytes":"AQID"}}}`, false},
+		{"string slice", map[string]interface{}{"a": []string{"x", "y"}}, `{"a":["x","y"]}`, false},
+		{"NaN", map[string]interface{}{"a": math.NaN()}, "", true},
+		{"infinity", map[string]interface{}{"a": math.Inf(1)}, "", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			bz, err := MarshalAttributes(test.attributes)
+			if test.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", bz)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(bz) != test.want {
+				t.Errorf("MarshalAttributes = %s, want %s", bz, test.want)
+			}
+		})
+	}
+}
+
+func TestUnMarshalAttributeValue(t *testing.T) {
+	tests := []struct {
+		json string
+		want interface{}
+		err  bool
+	}{
+		{"3", int64(3), false},
+		{"-9223372036854775808", int64(math.MinInt64), false},
+		{"9223372036854775807", int64(math.MaxInt64), false},
+		{"9223372036854775808", nil, true},
+		{"-9223372036854775809", nil, true},
+		{"3.0", 3.0, false},
+		{"3e0", 3.0, false},
+		{"1E2", 100.0, false},
+		{`"3"`, "3", false},
+		{`{"/": {"bytes": "AQID"}}`, []byte{1, 2, 3}, false},
+		{`{"/": {"bytes": "AQID"}, "x": 1}`, map[string]interface{}{"/": map[string]interface{}{"bytes": "AQID"}, "x": int64(1)}, false},
+		{`{"/": {"bytes": "not base64"}}`, nil, true},
+		{`[1, [2.5]]`, []interface{}{int64(1), []interface{}{2.5}}, false},
+		{"null", nil, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.json, func(t *testing.T) {
+			value, err := UnMarshalAttributeValue([]byte(test.json))
+			if test.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %#v", value)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(value, test.want) {
+				t.Errorf("UnMarshalAttributeValue = %#v, want %#v", value, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeAttributeValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{"int", 3, int64(3)},
+		{"uint", uint32(3), int64(3)},
+		{"float", float32(0.5), 0.5},
+		{"string slice", []string{"x"}, []interface{}{"x"}},
+		{"int slice", []int{1, 2}, []interface{}{int64(1), int64(2)}},
+		{"nested map", map[string]int{"a": 1}, map[string]interface{}{"a": int64(1)}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			value, err := NormalizeAttributeValue(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(value, test.want) {
+				t.Errorf("NormalizeAttributeValue = %#v, want %#v", value, test.want)
+			}
+		})
+	}
+}
+
+func TestAttributeValuesEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     interface{}
+		b     interface{}
+		equal bool
+	}{
+		{"same int", int64(3), 3, true},
+		{"int and float", int64(3), 3.0, false},
+		{"string and int", "3", int64(3), false},
+		{"bytes", []byte{1}, []byte{1}, true},
+		{"bytes and string", []byte("AQ=="), "AQ==", false},
+		{"lists", []interface{}{int64(1), "x"}, []string{"1", "x"}, false},
+		{"maps", map[string]interface{}{"a": nil}, map[string]interface{}{"a": nil}, true},
+		{"null and missing", nil, map[string]interface{}{}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if attributeValuesEqual(test.a, test.b) != test.equal {
+				t.Errorf("attributeValuesEqual(%#v, %#v) = %v, want %v", test.a, test.b, !test.equal, test.equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SignFormatVersion is the version of the canonical signing format, included in the signed bytes.
+//
+// The signed bytes are the canonical JSON encoding of {"format": <version>, "<kind>": <payload>},
+// where kind is "record" or "transfer" and payload is the JSON representation of the record/transfer.
+// The hash that's signed is SHA256(SHA256(signed bytes)). See the registry README for test vectors.
+//
+// The JSON representation of a record has:
+//   - id, type, owner and version, always.
+//   - owners, threshold, ttl and links, only if set (i.e. a non-empty list, or a non-zero number).
+//   - attributes, always: null if the payload has no attributes (or null attributes), {} if they're empty.
+//     Attribute values are in their typed encoding (see MarshalAttributes), e.g. bytes are {"/": {"bytes": <base64>}}.
+//   - For each link, id always and label only if it's not empty.
+//
+// The JSON representation of a transfer has id, version and owner, always, and owners and threshold only if set.
+//
+// Canonical JSON has:
+//   - No whitespace.
+//   - Object keys sorted by their UTF-8 bytes (unlike JavaScript's default sort, which uses UTF-16 code units).
+//   - Strings escaped using only \" \\ and \u00xx (lowercase hex) for control characters (including \b, \f, \n,
+//     \r and \t, which JSON.stringify writes in short form); all other characters as UTF-8. Payload strings must be
+//     valid UTF-8 (checked by ValidateBasic), as JSON encoding replaces invalid UTF-8 with U+FFFD.
+//   - Integers (64-bit) as decimal digits, without exponent or fraction, keeping their exact value (-0 is written as 0).
+//   - Floats formatted as ECMAScript's Number.prototype.toString (i.e. JSON.stringify) does, and then, if that has
+//     neither a "." nor an "e", followed by ".0" (which JSON.stringify doesn't add), e.g. 3.0, 0.5, 1.5e-7 and 1e+21.
+//     NaN and infinities are rejected.
+//
+// Integers and floats (e.g. 3 and 3.0) have distinct canonical forms, so that signatures don't allow type changes.
+//
+// Version 2 added the record `ttl`.
+const SignFormatVersion = 2
+
+// GetRecordSignBytes returns the canonical bytes signed by record owners.
+func GetRecordSignBytes(record Record) ([]byte, error) {
+	return getSignBytes("record", record)
+}
+
+// GetTransferSignBytes returns the canonical bytes signed for a record transfer.
+func GetTransferSignBytes(transfer Transfer) ([]byte, error) {
+	return getSignBytes("transfer", transfer)
+}
+
+func getSignBytes(kind string, payload interface{}) ([]byte, error) {
+	return CanonicalJSON(map[string]interface{}{
+		"format": SignFormatVersion,
+		kind:     payload,
+	})
+}
+
+// CanonicalJSON returns the canonical JSON encoding of a value (see SignFormatVersion).
+func CanonicalJSON(val interface{}) ([]byte, error) {
+	bz, err := json.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode numbers as json.Number, so that integers keep their precision.
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := writeCanonical(&buffer, generic); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeCanonical(buffer *bytes.Buffer, val interface{}) error {
+	switch val := val.(type) {
+	case nil:
+		buffer.WriteString("null")
+	case bool:
+		buffer.WriteString(strconv.FormatBool(val))
+	case string:
+		return writeCanonicalString(buffer, val)
+	case json.Number:
+		num, err := canonicalNumber(val)
+		if err != nil {
+			return err
+		}
+
+		buffer.WriteString(num)
+	case []interface{}:
+		buffer.WriteByte('[')
+		for index, item := range val {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+
+			if err := writeCanonical(buffer, item); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+
+		// Go compares strings by their bytes.
+		sort.Strings(keys)
+
+		buffer.WriteByte('{')
+		for index, key := range keys {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+
+			if err := writeCanonicalString(buffer, key); err != nil {
+				return err
+			}
+
+			buffer.WriteByte(':')
+
+			if err := writeCanonical(buffer, val[key]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	default:
+		return fmt.Errorf("unsupported type %T", val)
+	}
+
+	return nil
+}
+
+func writeCanonicalString(buffer *bytes.Buffer, str string) error {
+	buffer.WriteByte('"')
+	for _, r := range str {
+		switch {
+		case r == '"':
+			buffer.WriteString(`\"`)
+		case r == '\\':
+			buffer.WriteString(`\\`)
+		case r < 0x20:
+			buffer.WriteString(fmt.Sprintf(`\u%04x`, r))
+		default:
+			buffer.WriteRune(r)
+		}
+	}
+	buffer.WriteByte('"')
+
+	return nil
+}
+
+// canonicalNumber formats a number in its typed encoding (see MarshalAttributes), i.e. integers are written without
+// a fraction or exponent, and floats with one.
+func canonicalNumber(num json.Number) (string, error) {
+	str := num.String()
+
+	// Integers are kept as is (apart from normalizing negative zero), so that they don't lose precision.
+	// Integers that don't fit in 64 bits are rejected (attribute values must also fit in an int64, see
+	// toTypedAttributeValue), rather than being rounded as floats.
+	if !strings.ContainsAny(str, ".eE") {
+		if _, err := strconv.ParseInt(str, 10, 64); err != nil {
+			if _, err := strconv.ParseUint(str, 10, 64); err != nil {
+				return "", fmt.Errorf("integer %s out of range", str)
+			}
+		}
+
+		if str == "-0" {
+			return "0", nil
+		}
+
+		return str, nil
+	}
+
+	val, err := num.Float64()
+	if err != nil {
+		return "", err
+	}
+
+	formatted, err := formatECMAScriptNumber(val)
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.ContainsAny(formatted, ".e") {
+		formatted += ".0"
+	}
+
+	return formatted, nil
+}
+
+// formatECMAScriptNumber formats a number the way ECMAScript's Number.prototype.toString does.
+func formatECMAScriptNumber(val float64) (string, error) {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return "", errors.New("unsupported number")
+	}
+
+	if val == 0 {
+		return "0", nil
+	}
+
+	abs := math.Abs(val)
+	if abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	}
+
+	// Exponent form, without zero padding of the exponent (e.g. 1e-7, 1e+21).
+	str := strconv.FormatFloat(val, 'e', -1, 64)
+	mantissa, exponent := str[:strings.Index(str, "e")], str[strings.Index(str, "e")+1:]
+	sign := exponent[:1]
+	exponent = strings.TrimLeft(exponent[1:], "0")
+
+	return mantissa + "e" + sign + exponent, nil
+}
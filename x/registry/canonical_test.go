@@ -0,0 +1,166 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Test vectors from the registry README (see SignFormatVersion).
+var signBytesVectors = []struct {
+	name    string
+	payload string
+	bytes   string
+	hash    string
+}{
+	{
+		name:    "record",
+		payload: `{"record": {"id": "wrn:record:vector-2", "type": "wrn:registry-type:service", "owner": "02e840ed2d4c3e0b4e068f0d4be811b095ec78d5", "owners": ["02e840ed2d4c3e0b4e068f0d4be811b095ec78d5", "002aee66c9908426658a39d7e95a48646d172d0f"], "threshold": 2, "version": 3, "ttl": 1000, "attributes": {"label": "Weather\nService é", "replicas": 3, "load": 0.5, "big": 1e21, "small": 1.5e-7, "region": null, "Zone": "us-west", "tags": ["weather", 2], "cert": {"/": {"bytes": "AQID"}}}, "links": [{"id": "wrn:record:vector-1", "label": "depends"}, {"id": "wrn:record:vector-3"}]}}`,
+		bytes:   `{"format":2,"record":{"attributes":{"Zone":"us-west","big":1e+21,"cert":{"/":{"bytes":"AQID"}},"label":"Weather\u000aService é","load":0.5,"region":null,"replicas":3,"small":1.5e-7,"tags":["weather",2]},"id":"wrn:record:vector-2","links":[{"id":"wrn:record:vector-1","label":"depends"},{"id":"wrn:record:vector-3"}],"owner":"02e840ed2d4c3e0b4e068f0d4be811b095ec78d5","owners":["02e840ed2d4c3e0b4e068f0d4be811b095ec78d5","002aee66c9908426658a39d7e95a48646d172d0f"],"threshold":2,"ttl":1000,"type":"wrn:registry-type:service","version":3}}`,
+		hash:    "97b92416f1d75b272895acce0d034de4c3517d139b92e996577ca3640b346334",
+	},
+	{
+		name:    "record without attributes",
+		payload: `{"record": {"id": "wrn:record:vector-3", "type": "wrn:registry-type:service", "owner": "02e840ed2d4c3e0b4e068f0d4be811b095ec78d5", "version": 1}}`,
+		bytes:   `{"format":2,"record":{"attributes":null,"id":"wrn:record:vector-3","owner":"02e840ed2d4c3e0b4e068f0d4be811b095ec78d5","type":"wrn:registry-type:service","version":1}}`,
+		hash:    "0b7f6251331fe575184f3328053eabfb28c1e1761e2b02fcbe5391c5effa65c3",
+	},
+	{
+		name:    "transfer",
+		payload: `{"transfer": {"id": "wrn:record:vector-2", "version": 3, "owner": "002aee66c9908426658a39d7e95a48646d172d0f"}}`,
+		bytes:   `{"format":2,"transfer":{"id":"wrn:record:vector-2","owner":"002aee66c9908426658a39d7e95a48646d172d0f","version":3}}`,
+		hash:    "963a87e63a9cc1caaee8ef03dc23b6280963ef2cc584f6c546e201c1080c072c",
+	},
+}
+
+func TestSignBytesVectors(t *testing.T) {
+	for _, vector := range signBytesVectors {
+		vector := vector
+		t.Run(vector.name, func(t *testing.T) {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(vector.payload), &fields); err != nil {
+				t.Fatal(err)
+			}
+
+			var signBytes, hash []byte
+			var err error
+
+			if _, ok := fields["transfer"]; ok {
+				var payload TransferPayload
+				if err := json.Unmarshal([]byte(vector.payload), &payload); err != nil {
+					t.Fatal(err)
+				}
+
+				signBytes, err = GetTransferSignBytes(payload.Transfer)
+				hash = GenTransferHash(payload.Transfer)
+			} else {
+				var payload Payload
+				if err := json.Unmarshal([]byte(vector.payload), &payload); err != nil {
+					t.Fatal(err)
+				}
+
+				signBytes, err = GetRecordSignBytes(payload.Record)
+				hash = GenRecordHash(payload.Record)
+
+				// Records are signed again on chain, after being stored as a RecordObj.
+				stored := RecordObjToRecord(PayloadToPayloadObj(payload).Record)
+				if storedHash := BytesToHex(GenRecordHash(stored)); storedHash != vector.hash {
+					t.Errorf("stored record hash = %s, want %s", storedHash, vector.hash)
+				}
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(signBytes) != vector.bytes {
+				t.Errorf("sign bytes = %s, want %s", signBytes, vector.bytes)
+			}
+
+			if BytesToHex(hash) != vector.hash {
+				t.Errorf("hash = %s, want %s", BytesToHex(hash), vector.hash)
+			}
+		})
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+		err   bool
+	}{
+		{"sorted keys", map[string]interface{}{"b": 1, "a": 2, "B": 3}, `{"B":3,"a":2,"b":1}`, false},
+		{"control characters", "a\tb\x01", `"a\u0009b\u0001"`, false},
+		{"quotes and backslashes", `"\`, `"\"\\"`, false},
+		{"html characters", "<&>", `"<&>"`, false},
+		{"large integer", json.RawMessage("9007199254740993"), "9007199254740993", false},
+		{"negative zero", json.RawMessage("-0"), "0", false},
+		{"whole float", json.RawMessage("3.0"), "3.0", false},
+		{"float exponent", json.RawMessage("1e21"), "1e+21", false},
+		{"small float", json.RawMessage("0.00000015"), "1.5e-7", false},
+		{"nested lists", []interface{}{nil, true, []interface{}{"x"}}, `[null,true,["x"]]`, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			bz, err := CanonicalJSON(test.value)
+			if test.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", bz)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(bz) != test.want {
+				t.Errorf("CanonicalJSON = %s, want %s", bz, test.want)
+			}
+		})
+	}
+}
+
+func TestValidateBasicRejectsInvalidUTF8(t *testing.T) {
+	owner := "02e840ed2d4c3e0b4e068f0d4be811b095ec78d5"
+	signer := sdk.AccAddress(BytesFromHex(owner))
+	signatures := []Signature{{PubKey: "key", Signature: "sig"}}
+	invalid := "wrn:record:\xff"
+
+	records := map[string]RecordObj{
+		"id":         {ID: ID(invalid), Type: "wrn:registry-type:service", Owner: owner, Version: 1},
+		"type":       {ID: "wrn:record:1", Type: invalid, Owner: owner, Version: 1},
+		"link label": {ID: "wrn:record:1", Type: "wrn:registry-type:service", Owner: owner, Version: 1, Links: []Link{{ID: "wrn:record:2", Label: invalid}}},
+		"attributes": {ID: "wrn:record:1", Type: "wrn:registry-type:service", Owner: owner, Version: 1, Attributes: []byte("{\"label\":\"\xff\"}")},
+	}
+
+	for name, record := range records {
+		record := record
+		t.Run(name, func(t *testing.T) {
+			msg := NewMsgSetRecord(PayloadObj{Record: record, Signatures: signatures}, signer, false, 0, false)
+			if err := msg.ValidateBasic(); err == nil {
+				t.Error("expected invalid UTF-8 to be rejected")
+			}
+		})
+	}
+
+	t.Run("transfer", func(t *testing.T) {
+		msg := NewMsgTransferRecord(TransferPayload{
+			Transfer:   Transfer{ID: "wrn:record:1", Version: 1, Owner: owner, Owners: []string{invalid}},
+			Signatures: signatures,
+		}, signer)
+		if err := msg.ValidateBasic(); err == nil {
+			t.Error("expected invalid UTF-8 to be rejected")
+		}
+	})
+}
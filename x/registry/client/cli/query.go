@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -13,12 +14,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	"github.com/wirelineio/registry/x/registry"
 )
 
-// GetCmdList queries all records.
+// GetCmdList queries a page of records, optionally filtered by type, owner and an attribute filter.
 func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List records.",
 		Args:  cobra.ExactArgs(0),
@@ -27,7 +29,37 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), nil)
+			query := registry.ListRecordsRequest{
+				RecordQuery: registry.RecordQuery{
+					Type:  viper.GetString("type"),
+					Owner: viper.GetString("owner"),
+				},
+				PageRequest: registry.PageRequest{
+					First:      viper.GetInt("first"),
+					After:      viper.GetString("after"),
+					OrderBy:    viper.GetString("order-by"),
+					Descending: viper.GetBool("descending"),
+					Count:      viper.GetBool("count"),
+				},
+			}
+
+			if filter := viper.GetString("filter"); filter != "" {
+				query.Filter = &registry.Filter{}
+				if err := json.Unmarshal([]byte(filter), query.Filter); err != nil {
+					return err
+				}
+
+				if err := query.Filter.Validate(); err != nil {
+					return err
+				}
+			}
+
+			data, err := json.Marshal(query)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), data)
 			if err != nil {
 				return err
 			}
@@ -37,13 +69,24 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().String("type", "", "Only list records of this type.")
+	cmd.Flags().String("owner", "", "Only list records with this owner.")
+	cmd.Flags().String("filter", "", "Only list records matching this attribute filter (JSON, e.g. '{\"key\": \"name\", \"op\": \"prefix\", \"value\": \"echo\"}').")
+	cmd.Flags().Int("first", 0, fmt.Sprintf("Max number of records to list (default: %d, max: %d).", registry.DefaultPageSize, registry.MaxPageSize))
+	cmd.Flags().String("after", "", "List records after this cursor (endCursor of the previous page).")
+	cmd.Flags().String("order-by", "", "Order records by id (default), type, owner or updateHeight.")
+	cmd.Flags().Bool("descending", false, "List records in descending order.")
+	cmd.Flags().Bool("count", false, "Also count the records matching the query (in all pages).")
+
+	return cmd
 }
 
 // GetCmdGetResource queries a record record.
 func GetCmdGetResource(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "get [ID]",
-		Short: "Get record.",
+		Use:   "get [ID[@version]]",
+		Short: "Get record, or a specific revision of it.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viper.Set("trust-node", true)
@@ -63,10 +106,58 @@ func GetCmdGetResource(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdHistory queries the revision history of a record.
+func GetCmdHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history [ID]",
+		Short: "Get record revision history.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id := args[0]
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/history/%s", queryRoute, id), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+// GetCmdResolveName resolves a name to a record.
+func GetCmdResolveName(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resolve [name]",
+		Short: "Resolve name to record.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name := args[0]
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/resolve/%s", queryRoute, name), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
 // GetCmdGraph generates a dot graph.
 func GetCmdGraph(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "graph",
+		Use:   "graph [ID]",
 		Short: "Generate dot graph.",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -121,6 +212,94 @@ func GetCmdKey(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdSignBytes prints the canonical bytes and hash signed for a record or transfer payload,
+// and checks the payload signatures against them.
+func GetCmdSignBytes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-bytes [payload file path]",
+		Short: "Print canonical sign bytes and hash for a record or transfer payload.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var fields map[string]interface{}
+			err := readYAMLFile(args[0], &fields)
+			if err != nil {
+				return err
+			}
+
+			var signBytes, hash []byte
+			var signatures []registry.Signature
+
+			if _, ok := fields["transfer"]; ok {
+				var payload registry.TransferPayload
+				if err := readYAMLFile(args[0], &payload); err != nil {
+					return err
+				}
+
+				if signBytes, err = registry.GetTransferSignBytes(payload.Transfer); err != nil {
+					return err
+				}
+
+				hash = registry.GenTransferHash(payload.Transfer)
+				signatures = payload.Signatures
+			} else {
+				payload, err := getPayloadFromFile(args[0])
+				if err != nil {
+					return err
+				}
+
+				if signBytes, err = registry.GetRecordSignBytes(payload.Record); err != nil {
+					return err
+				}
+
+				hash = registry.GenRecordHash(payload.Record)
+				signatures = payload.Signatures
+			}
+
+			fmt.Println("Format    :", registry.SignFormatVersion)
+			fmt.Println("Bytes     :", string(signBytes))
+			fmt.Println("Hash      :", registry.BytesToHex(hash))
+
+			for _, sig := range signatures {
+				pubKey, err := cryptoAmino.PubKeyFromBytes(registry.BytesFromBase64(sig.PubKey))
+				if err != nil {
+					return err
+				}
+
+				status := "valid"
+				if !pubKey.VerifyBytes(hash, registry.BytesFromBase64(sig.Signature)) {
+					status = "INVALID"
+				}
+
+				fmt.Println("Signature :", registry.GetAddressFromPubKey(pubKey), status)
+			}
+
+			return nil
+		},
+	}
+}
+
+// GetCmdParams queries the registry params.
+func GetCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Get registry params.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
 // GetCmdTest testing.
 func GetCmdTest(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -7,15 +7,17 @@ package cli
 import (
 	"fmt"
 	"io/ioutil"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	regutils "github.com/wirelineio/registry/client/utils"
+	admincli "github.com/wirelineio/registry/x/admin/client/cli"
 	"github.com/wirelineio/registry/x/registry"
 )
 
@@ -47,17 +49,28 @@ func GetCmdSetResource(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := registry.NewMsgSetRecord(registry.PayloadToPayloadObj(payload), signer)
+			// Read from the flag, as the vendored viper version doesn't support uint64 values.
+			expectedVersion, err := cmd.Flags().GetUint64("expected-version")
+			if err != nil {
+				return err
+			}
+
+			allowDanglingLinks := viper.GetBool("allow-dangling-links")
+			createOnly := viper.GetBool("create-only")
+			msg := registry.NewMsgSetRecord(registry.PayloadToPayloadObj(payload), signer, allowDanglingLinks, expectedVersion, createOnly)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 
-			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().Bool("sign-only", false, "Only sign the transaction payload.")
+	cmd.Flags().Bool("allow-dangling-links", false, "Allow links to records that don't exist.")
+	cmd.Flags().Uint64("expected-version", 0, "Only set the record if it's currently at this version.")
+	cmd.Flags().Bool("create-only", false, "Only set the record if it doesn't exist.")
 
 	return cmd
 }
@@ -96,7 +109,7 @@ func GetCmdDeleteResource(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 
@@ -105,8 +118,88 @@ func GetCmdDeleteResource(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdRenewResource is the CLI command for extending the expiry of a record.
+func GetCmdRenewResource(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew [ID] [blocks]",
+		Short: "Renew record, paying rent for the given number of blocks.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc).WithChainID(registry.WirelineChainID)
+
+			cliCtx.PrintResponse = true
+
+			ttl, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := registry.NewMsgRenewRecord(registry.ID(args[0]), ttl, signer)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdTransferResource is the CLI command for transferring a record to new owners.
+func GetCmdTransferResource(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [payload file path]",
+		Short: "Offer, accept or complete record transfer.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc).WithChainID(registry.WirelineChainID)
+
+			var payload registry.TransferPayload
+			err := readYAMLFile(args[0], &payload)
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			signOnly := viper.GetBool("sign-only")
+			if signOnly {
+				return signTransfer(payload)
+			}
+
+			signer, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := registry.NewMsgTransferRecord(payload, signer)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool("sign-only", false, "Only sign the transfer payload.")
+
+	return cmd
+}
+
 // GetCmdClearResources is the CLI command for clearing all records.
-// NOTE: FOR LOCAL TESTING PURPOSES ONLY!
+// NOTE: Only allowed on dev networks (or for admins).
 func GetCmdClearResources(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clear",
@@ -124,34 +217,138 @@ func GetCmdClearResources(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			if err := admincli.EnsureDevMode(cliCtx, "admin", signer); err != nil {
+				return err
+			}
+
 			msg := registry.NewMsgClearRecords(signer)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 
-			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+			return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 
 	return cmd
 }
 
+// GetCmdReserveName is the CLI command for reserving a name.
+func GetCmdReserveName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-name [name]",
+		Short: "Reserve name.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return completeAndBroadcastNameMsg(cdc, func(signer sdk.AccAddress) (sdk.Msg, error) {
+				return registry.NewMsgReserveName(args[0], signer), nil
+			})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSetName is the CLI command for pointing a name at a record.
+func GetCmdSetName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-name [name] [ID]",
+		Short: "Point name at record.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return completeAndBroadcastNameMsg(cdc, func(signer sdk.AccAddress) (sdk.Msg, error) {
+				return registry.NewMsgSetName(args[0], registry.ID(args[1]), signer), nil
+			})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdTransferName is the CLI command for transferring a name to another account.
+func GetCmdTransferName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-name [name] [new owner address]",
+		Short: "Transfer name.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return completeAndBroadcastNameMsg(cdc, func(signer sdk.AccAddress) (sdk.Msg, error) {
+				newOwner, err := sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				return registry.NewMsgTransferName(args[0], newOwner, signer), nil
+			})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdReleaseName is the CLI command for releasing a name.
+func GetCmdReleaseName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-name [name]",
+		Short: "Release name.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return completeAndBroadcastNameMsg(cdc, func(signer sdk.AccAddress) (sdk.Msg, error) {
+				return registry.NewMsgReleaseName(args[0], signer), nil
+			})
+		},
+	}
+
+	return cmd
+}
+
+// Build, sign and broadcast a naming service msg from the --from account.
+func completeAndBroadcastNameMsg(cdc *codec.Codec, newMsg func(signer sdk.AccAddress) (sdk.Msg, error)) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+	txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc).WithChainID(registry.WirelineChainID)
+
+	cliCtx.PrintResponse = true
+
+	signer, err := cliCtx.GetFromAddress()
+	if err != nil {
+		return err
+	}
+
+	msg, err := newMsg(signer)
+	if err != nil {
+		return err
+	}
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	return regutils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+}
+
 // Load payload object from YAML file.
 func getPayloadFromFile(filePath string) (registry.Payload, error) {
 	var payload registry.Payload
 
-	data, err := ioutil.ReadFile(filePath)
+	err := readYAMLFile(filePath, &payload)
 	if err != nil {
 		return payload, err
 	}
 
-	err = yaml.Unmarshal(data, &payload)
+	return payload, nil
+}
+
+// Load object from YAML file.
+func readYAMLFile(filePath string, obj interface{}) error {
+	data, err := ioutil.ReadFile(filePath)
 	if err != nil {
-		return payload, err
+		return err
 	}
 
-	return payload, nil
+	return yaml.Unmarshal(data, obj)
 }
 
 // Sign payload object.
@@ -169,3 +366,19 @@ func signResource(payload registry.Payload) error {
 
 	return nil
 }
+
+// Sign transfer payload object.
+func signTransfer(payload registry.TransferPayload) error {
+	name := viper.GetString("from")
+
+	sigBytes, pubKey, err := registry.GetTransferSignature(payload.Transfer, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Address   :", registry.GetAddressFromPubKey(pubKey))
+	fmt.Println("PubKey    :", registry.BytesToBase64(pubKey.Bytes()))
+	fmt.Println("Signature :", registry.BytesToBase64(sigBytes))
+
+	return nil
+}
@@ -32,9 +32,13 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 	regQueryCmd.AddCommand(client.GetCommands(
 		regcmd.GetCmdGetResource("registry", mc.cdc),
 		regcmd.GetCmdList("registry", mc.cdc),
+		regcmd.GetCmdHistory("registry", mc.cdc),
+		regcmd.GetCmdResolveName("registry", mc.cdc),
 		regcmd.GetCmdGraph("registry", mc.cdc),
 		regcmd.GetCmdTest("registry", mc.cdc),
 		regcmd.GetCmdKey("registry", mc.cdc),
+		regcmd.GetCmdSignBytes("registry", mc.cdc),
+		regcmd.GetCmdParams("registry", mc.cdc),
 	)...)
 
 	return regQueryCmd
@@ -50,7 +54,13 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 	regTxCmd.AddCommand(client.PostCommands(
 		regcmd.GetCmdSetResource(mc.cdc),
 		regcmd.GetCmdDeleteResource(mc.cdc),
+		regcmd.GetCmdRenewResource(mc.cdc),
+		regcmd.GetCmdTransferResource(mc.cdc),
 		regcmd.GetCmdClearResources(mc.cdc),
+		regcmd.GetCmdReserveName(mc.cdc),
+		regcmd.GetCmdSetName(mc.cdc),
+		regcmd.GetCmdTransferName(mc.cdc),
+		regcmd.GetCmdReleaseName(mc.cdc),
 	)...)
 
 	return regTxCmd
@@ -12,5 +12,11 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetRecord{}, "registry/SetResource", nil)
 	cdc.RegisterConcrete(MsgDeleteRecord{}, "registry/DeleteResource", nil)
+	cdc.RegisterConcrete(MsgRenewRecord{}, "registry/RenewResource", nil)
+	cdc.RegisterConcrete(MsgTransferRecord{}, "registry/TransferResource", nil)
 	cdc.RegisterConcrete(MsgClearRecords{}, "registry/ClearResources", nil)
+	cdc.RegisterConcrete(MsgReserveName{}, "registry/ReserveName", nil)
+	cdc.RegisterConcrete(MsgSetName{}, "registry/SetName", nil)
+	cdc.RegisterConcrete(MsgTransferName{}, "registry/TransferName", nil)
+	cdc.RegisterConcrete(MsgReleaseName{}, "registry/ReleaseName", nil)
 }
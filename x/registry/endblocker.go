@@ -0,0 +1,23 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker prunes records that have expired, returning a tag for each, followed by tags for the links to it
+// that are left dangling.
+func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
+	tags := sdk.EmptyTags()
+
+	for _, id := range keeper.GetExpiredRecords(ctx, ctx.BlockHeight()) {
+		keeper.DeleteResource(ctx, id)
+		tags = tags.AppendTag(TagExpiredRecord, []byte(id))
+		tags = tags.AppendTags(getDanglingLinkTags(ctx, keeper, id))
+	}
+
+	return tags
+}
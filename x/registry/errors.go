@@ -0,0 +1,59 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Registry errors reserve 101 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = "registry"
+
+	CodeInvalidVersion  sdk.CodeType = 101
+	CodeVersionConflict sdk.CodeType = 102
+	CodeRecordExists    sdk.CodeType = 103
+	CodeLimitExceeded   sdk.CodeType = 104
+	CodeTypeNotAllowed  sdk.CodeType = 105
+	CodeLinkNotFound    sdk.CodeType = 106
+	CodeTypeInUse       sdk.CodeType = 107
+)
+
+// ErrInvalidVersion is returned when a signed payload doesn't have the expected record version.
+func ErrInvalidVersion(expected uint64, actual uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidVersion,
+		"Invalid record version %d, expected %d (stale or replayed payload?).", actual, expected)
+}
+
+// ErrVersionConflict is returned when a record isn't at the version expected by a compare-and-swap write.
+func ErrVersionConflict(expected uint64, actual uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeVersionConflict,
+		"Record version conflict, expected version %d but found %d.", expected, actual)
+}
+
+// ErrRecordExists is returned when a create-only write targets an existing record.
+func ErrRecordExists(id ID) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeRecordExists, "Record %s already exists.", id)
+}
+
+// ErrLimitExceeded is returned when a write exceeds a limit set by the registry params.
+func ErrLimitExceeded(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeLimitExceeded, msg)
+}
+
+// ErrTypeNotAllowed is returned when the record type doesn't have one of the prefixes allowed by the registry params.
+func ErrTypeNotAllowed(recordType string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeTypeNotAllowed, "Record type %s is not allowed.", recordType)
+}
+
+// ErrLinkNotFound is returned when a record links to a record that doesn't exist, and dangling links aren't allowed.
+func ErrLinkNotFound(id ID) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeLinkNotFound, "Link target not found: %s.", id)
+}
+
+// ErrTypeInUse is returned when a type definition would be deleted or changed incompatibly while records of the type exist.
+func ErrTypeInUse(recordType string, msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeTypeInUse, "Type %s is in use: %s.", recordType, msg)
+}
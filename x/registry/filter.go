@@ -0,0 +1,419 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Filter operators, applied to the record attribute named by the filter key.
+const (
+	FilterOpEq        = "eq"
+	FilterOpGt        = "gt"
+	FilterOpGte       = "gte"
+	FilterOpLt        = "lt"
+	FilterOpLte       = "lte"
+	FilterOpPrefix    = "prefix"
+	FilterOpSubstring = "substring"
+	FilterOpIn        = "in"
+	FilterOpExists    = "exists"
+	FilterOpNotExists = "notExists"
+	FilterOpNull      = "null"
+	FilterOpContains  = "contains"
+)
+
+// Filter limits, so that matching a filter against a record has a bounded cost.
+const (
+	MaxFilterDepth      = 8
+	MaxFilterConditions = 64
+	MaxFilterInValues   = 100
+)
+
+// Filter keys for record metadata (see RecordMetadata), instead of attributes.
+// Times are compared as Unix timestamps (seconds).
+const (
+	FilterKeyCreateHeight = "$createHeight"
+	FilterKeyCreateTime   = "$createTime"
+	FilterKeyUpdateHeight = "$updateHeight"
+	FilterKeyUpdateTime   = "$updateTime"
+	FilterKeyTxHash       = "$txHash"
+	FilterKeyExpiryHeight = "$expiryHeight"
+)
+
+// Filter is a record attribute (or metadata) filter.
+// A filter is either a condition (key, op and value) or a composition (and, or, not) of filters.
+//
+// Conditions:
+// - eq: attribute equals value (of the same type, e.g. 3 doesn't equal 3.0), which is required (see null).
+// - gt, gte, lt, lte: compares numbers (integers and floats) or strings.
+// - prefix, substring: matches string attributes.
+// - in: attribute equals one of the values in the value list (of up to MaxFilterInValues values).
+// - exists, notExists: attribute is (not) set; value isn't used.
+// - null: attribute is set to null; value isn't used.
+// - contains: attribute is a list that contains value, which is required.
+//
+// Filters can be nested up to MaxFilterDepth levels, with up to MaxFilterConditions conditions in all.
+type Filter struct {
+	Key   string      `json:"key,omitempty"`
+	Op    string      `json:"op,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+
+	And []Filter `json:"and,omitempty"`
+	Or  []Filter `json:"or,omitempty"`
+	Not *Filter  `json:"not,omitempty"`
+}
+
+// filterJSON is used to (un)marshal filters, with values in their typed encoding (see MarshalAttributes).
+type filterJSON struct {
+	Key   string          `json:"key,omitempty"`
+	Op    string          `json:"op,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+
+	And []Filter `json:"and,omitempty"`
+	Or  []Filter `json:"or,omitempty"`
+	Not *Filter  `json:"not,omitempty"`
+}
+
+// MarshalJSON encodes the filter, preserving the value type.
+func (filter Filter) MarshalJSON() ([]byte, error) {
+	val := filterJSON{Key: filter.Key, Op: filter.Op, And: filter.And, Or: filter.Or, Not: filter.Not}
+
+	if filter.Value != nil {
+		var buffer bytes.Buffer
+		if err := writeAttributeValue(&buffer, filter.Value); err != nil {
+			return nil, err
+		}
+
+		val.Value = buffer.Bytes()
+	}
+
+	return json.Marshal(val)
+}
+
+// UnmarshalJSON decodes the filter, preserving the value type (see UnMarshalAttributes).
+func (filter *Filter) UnmarshalJSON(bz []byte) error {
+	var val filterJSON
+	if err := json.Unmarshal(bz, &val); err != nil {
+		return err
+	}
+
+	*filter = Filter{Key: val.Key, Op: val.Op, And: val.And, Or: val.Or, Not: val.Not}
+
+	if len(val.Value) > 0 {
+		value, err := UnMarshalAttributeValue(val.Value)
+		if err != nil {
+			return err
+		}
+
+		filter.Value = value
+	}
+
+	return nil
+}
+
+// Validate checks that the filter is well formed, and within the filter limits.
+func (filter Filter) Validate() error {
+	conditions := 0
+
+	return filter.validate(1, &conditions)
+}
+
+// validate checks the filter at the given nesting depth, adding its conditions to the count.
+func (filter Filter) validate(depth int, conditions *int) error {
+	if depth > MaxFilterDepth {
+		return fmt.Errorf("filters can't be nested more than %d levels", MaxFilterDepth)
+	}
+
+	parts := 0
+	if filter.Key != "" || filter.Op != "" {
+		parts++
+	}
+	if filter.And != nil {
+		parts++
+	}
+	if filter.Or != nil {
+		parts++
+	}
+	if filter.Not != nil {
+		parts++
+	}
+
+	if parts != 1 {
+		return errors.New("filter must have exactly one of a condition (key, op, value), and, or, not")
+	}
+
+	for _, subFilters := range [][]Filter{filter.And, filter.Or} {
+		for _, subFilter := range subFilters {
+			if err := subFilter.validate(depth+1, conditions); err != nil {
+				return err
+			}
+		}
+	}
+
+	if filter.Not != nil {
+		return filter.Not.validate(depth+1, conditions)
+	}
+
+	if filter.And != nil || filter.Or != nil {
+		return nil
+	}
+
+	*conditions++
+	if *conditions > MaxFilterConditions {
+		return fmt.Errorf("filters can't have more than %d conditions", MaxFilterConditions)
+	}
+
+	if filter.Key == "" {
+		return errors.New("filter key is required")
+	}
+
+	switch filter.Op {
+	case FilterOpExists, FilterOpNotExists, FilterOpNull:
+	case FilterOpEq, FilterOpContains:
+		// Use exists to check that an attribute is set, and null to check that it's null.
+		if filter.Value == nil {
+			return fmt.Errorf("filter op '%s' requires a value (use '%s' to match null values)", filter.Op, FilterOpNull)
+		}
+	case FilterOpGt, FilterOpGte, FilterOpLt, FilterOpLte:
+		switch filter.Value.(type) {
+		case int64, float64, string:
+		default:
+			return fmt.Errorf("filter op '%s' requires a number or string value", filter.Op)
+		}
+	case FilterOpPrefix, FilterOpSubstring:
+		if _, ok := filter.Value.(string); !ok {
+			return fmt.Errorf("filter op '%s' requires a string value", filter.Op)
+		}
+	case FilterOpIn:
+		list, ok := filter.Value.([]interface{})
+		if !ok {
+			return fmt.Errorf("filter op '%s' requires a list value", filter.Op)
+		}
+
+		if len(list) > MaxFilterInValues {
+			return fmt.Errorf("filter op '%s' can't have more than %d values", filter.Op, MaxFilterInValues)
+		}
+	default:
+		return fmt.Errorf("invalid filter op '%s'", filter.Op)
+	}
+
+	return nil
+}
+
+// Matches checks if the record (with the given metadata) matches the filter, which must be valid (see Validate).
+func (filter Filter) Matches(record Record, metadata RecordMetadata) bool {
+	switch {
+	case filter.And != nil:
+		for _, subFilter := range filter.And {
+			if !subFilter.Matches(record, metadata) {
+				return false
+			}
+		}
+
+		return true
+	case filter.Or != nil:
+		for _, subFilter := range filter.Or {
+			if subFilter.Matches(record, metadata) {
+				return true
+			}
+		}
+
+		return false
+	case filter.Not != nil:
+		return !filter.Not.Matches(record, metadata)
+	}
+
+	value, exists := getFilterValue(record, metadata, filter.Key)
+
+	switch filter.Op {
+	case FilterOpExists:
+		return exists
+	case FilterOpNotExists:
+		return !exists
+	}
+
+	if !exists {
+		return false
+	}
+
+	switch filter.Op {
+	case FilterOpNull:
+		return value == nil
+	case FilterOpEq:
+		return attributeValuesEqual(value, filter.Value)
+	case FilterOpGt:
+		result, ok := compareAttributeValues(value, filter.Value)
+		return ok && result > 0
+	case FilterOpGte:
+		result, ok := compareAttributeValues(value, filter.Value)
+		return ok && result >= 0
+	case FilterOpLt:
+		result, ok := compareAttributeValues(value, filter.Value)
+		return ok && result < 0
+	case FilterOpLte:
+		result, ok := compareAttributeValues(value, filter.Value)
+		return ok && result <= 0
+	case FilterOpPrefix:
+		str, ok := value.(string)
+		prefix, okPrefix := filter.Value.(string)
+		return ok && okPrefix && strings.HasPrefix(str, prefix)
+	case FilterOpSubstring:
+		str, ok := value.(string)
+		substr, okSubstr := filter.Value.(string)
+		return ok && okSubstr && strings.Contains(str, substr)
+	case FilterOpIn:
+		list, ok := filter.Value.([]interface{})
+		return ok && containsAttributeValue(list, value)
+	case FilterOpContains:
+		list, ok := value.([]interface{})
+		return ok && containsAttributeValue(list, filter.Value)
+	}
+
+	return false
+}
+
+// UsesMetadata checks if the filter has conditions on record metadata.
+func (filter Filter) UsesMetadata() bool {
+	if isMetadataFilterKey(filter.Key) {
+		return true
+	}
+
+	for _, subFilters := range [][]Filter{filter.And, filter.Or} {
+		for _, subFilter := range subFilters {
+			if subFilter.UsesMetadata() {
+				return true
+			}
+		}
+	}
+
+	return filter.Not != nil && filter.Not.UsesMetadata()
+}
+
+// GetIndexedAttributes returns attribute values that matching records must have, for narrowing
+// down records using the attribute index (i.e. eq and null conditions, on their own or in an and).
+func (filter Filter) GetIndexedAttributes() map[string]interface{} {
+	attrs := make(map[string]interface{})
+
+	filters := filter.And
+	if filter.And == nil {
+		filters = []Filter{filter}
+	}
+
+	for _, subFilter := range filters {
+		if (subFilter.Op != FilterOpEq && subFilter.Op != FilterOpNull) || isMetadataFilterKey(subFilter.Key) {
+			continue
+		}
+
+		value := subFilter.Value
+		if subFilter.Op == FilterOpNull {
+			value = nil
+		}
+
+		if _, ok := getAttributeIndexValue(subFilter.Key, value); ok {
+			attrs[subFilter.Key] = value
+		}
+	}
+
+	return attrs
+}
+
+func isMetadataFilterKey(key string) bool {
+	switch key {
+	case FilterKeyCreateHeight, FilterKeyCreateTime, FilterKeyUpdateHeight, FilterKeyUpdateTime, FilterKeyTxHash, FilterKeyExpiryHeight:
+		return true
+	}
+
+	return false
+}
+
+// getFilterValue returns the record attribute or metadata value for a filter key.
+func getFilterValue(record Record, metadata RecordMetadata, key string) (interface{}, bool) {
+	switch key {
+	case FilterKeyCreateHeight:
+		return metadata.CreateHeight, true
+	case FilterKeyCreateTime:
+		return metadata.CreateTime.Unix(), true
+	case FilterKeyUpdateHeight:
+		return metadata.UpdateHeight, true
+	case FilterKeyUpdateTime:
+		return metadata.UpdateTime.Unix(), true
+	case FilterKeyTxHash:
+		return metadata.TxHash, true
+	case FilterKeyExpiryHeight:
+		// Records without expiry don't have an expiry height.
+		return metadata.ExpiryHeight, metadata.ExpiryHeight > 0
+	}
+
+	value, exists := record.Attributes[key]
+
+	return value, exists
+}
+
+// compareAttributeValues compares numbers (integers and floats) or strings.
+// Returns false if the values can't be compared.
+func compareAttributeValues(a interface{}, b interface{}) (int, bool) {
+	if strA, ok := a.(string); ok {
+		strB, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+
+		return strings.Compare(strA, strB), true
+	}
+
+	// Compare integers exactly, as large ones lose precision as floats.
+	if intA, ok := a.(int64); ok {
+		if intB, ok := b.(int64); ok {
+			switch {
+			case intA < intB:
+				return -1, true
+			case intA > intB:
+				return 1, true
+			}
+
+			return 0, true
+		}
+	}
+
+	numA, okA := toFloat(a)
+	numB, okB := toFloat(b)
+	if !okA || !okB {
+		return 0, false
+	}
+
+	switch {
+	case numA < numB:
+		return -1, true
+	case numA > numB:
+		return 1, true
+	}
+
+	return 0, true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch val := value.(type) {
+	case int64:
+		return float64(val), true
+	case float64:
+		return val, true
+	}
+
+	return 0, false
+}
+
+func containsAttributeValue(list []interface{}, value interface{}) bool {
+	for _, item := range list {
+		if attributeValuesEqual(item, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,194 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func parseTestFilter(t *testing.T, str string) Filter {
+	var filter Filter
+	if err := json.Unmarshal([]byte(str), &filter); err != nil {
+		t.Fatalf("invalid filter %s: %s", str, err)
+	}
+
+	return filter
+}
+
+func TestFilterValidate(t *testing.T) {
+	inValues := make([]string, MaxFilterInValues+1)
+	for i := range inValues {
+		inValues[i] = fmt.Sprintf("%d", i)
+	}
+
+	nested := `{"key": "a", "op": "exists"}`
+	for i := 0; i < MaxFilterDepth; i++ {
+		nested = fmt.Sprintf(`{"not": %s}`, nested)
+	}
+
+	conditions := make([]string, MaxFilterConditions+1)
+	for i := range conditions {
+		conditions[i] = `{"key": "a", "op": "exists"}`
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+		valid  bool
+	}{
+		{"eq", `{"key": "a", "op": "eq", "value": 1}`, true},
+		{"eq without value", `{"key": "a", "op": "eq"}`, false},
+		{"eq null", `{"key": "a", "op": "eq", "value": null}`, false},
+		{"null", `{"key": "a", "op": "null"}`, true},
+		{"exists", `{"key": "a", "op": "exists"}`, true},
+		{"missing key", `{"op": "exists"}`, false},
+		{"unknown op", `{"key": "a", "op": "like", "value": "x"}`, false},
+		{"gt number", `{"key": "a", "op": "gt", "value": 1.5}`, true},
+		{"gt list", `{"key": "a", "op": "gt", "value": [1]}`, false},
+		{"prefix number", `{"key": "a", "op": "prefix", "value": 1}`, false},
+		{"contains without value", `{"key": "a", "op": "contains"}`, false},
+		{"in", `{"key": "a", "op": "in", "value": [1, "x"]}`, true},
+		{"in not a list", `{"key": "a", "op": "in", "value": 1}`, false},
+		{"in at limit", fmt.Sprintf(`{"key": "a", "op": "in", "value": [%s]}`, strings.Join(inValues[:MaxFilterInValues], ",")), true},
+		{"in over limit", fmt.Sprintf(`{"key": "a", "op": "in", "value": [%s]}`, strings.Join(inValues, ",")), false},
+		{"condition and composition", `{"key": "a", "op": "exists", "and": [{"key": "b", "op": "exists"}]}`, false},
+		{"and", `{"and": [{"key": "a", "op": "exists"}, {"not": {"key": "b", "op": "null"}}]}`, true},
+		{"invalid nested condition", `{"or": [{"key": "a", "op": "exists"}, {"key": "b", "op": "eq"}]}`, false},
+		{"depth at limit", nested[len(`{"not": `) : len(nested)-1], true},
+		{"depth over limit", nested, false},
+		{"conditions at limit", fmt.Sprintf(`{"and": [%s]}`, strings.Join(conditions[:MaxFilterConditions], ",")), true},
+		{"conditions over limit", fmt.Sprintf(`{"and": [%s]}`, strings.Join(conditions, ",")), false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := parseTestFilter(t, test.filter).Validate()
+			if test.valid && err != nil {
+				t.Errorf("expected a valid filter, got %s", err)
+			}
+
+			if !test.valid && err == nil {
+				t.Error("expected an invalid filter")
+			}
+		})
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	attributes, err := UnMarshalAttributes([]byte(`{"name": "weather", "replicas": 3, "load": 0.5, "region": null, "tags": ["a", 2], "big": 9007199254740993}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := Record{ID: "wrn:record:1", Type: "wrn:registry-type:service", Attributes: attributes}
+	metadata := RecordMetadata{CreateHeight: 10, UpdateHeight: 20, TxHash: "ABCD"}
+
+	tests := []struct {
+		name    string
+		filter  string
+		matches bool
+	}{
+		{"eq string", `{"key": "name", "op": "eq", "value": "weather"}`, true},
+		{"eq int", `{"key": "replicas", "op": "eq", "value": 3}`, true},
+		{"eq int as float", `{"key": "replicas", "op": "eq", "value": 3.0}`, false},
+		{"eq missing", `{"key": "missing", "op": "eq", "value": "x"}`, false},
+		{"eq large int", `{"key": "big", "op": "eq", "value": 9007199254740993}`, true},
+		{"gt large int", `{"key": "big", "op": "gt", "value": 9007199254740992}`, true},
+		{"null", `{"key": "region", "op": "null"}`, true},
+		{"null set", `{"key": "name", "op": "null"}`, false},
+		{"null missing", `{"key": "missing", "op": "null"}`, false},
+		{"exists null", `{"key": "region", "op": "exists"}`, true},
+		{"notExists", `{"key": "missing", "op": "notExists"}`, true},
+		{"gte", `{"key": "replicas", "op": "gte", "value": 3}`, true},
+		{"lt float", `{"key": "load", "op": "lt", "value": 1}`, true},
+		{"lte mixed types", `{"key": "name", "op": "lte", "value": 1}`, false},
+		{"prefix", `{"key": "name", "op": "prefix", "value": "wea"}`, true},
+		{"substring", `{"key": "name", "op": "substring", "value": "ath"}`, true},
+		{"in", `{"key": "replicas", "op": "in", "value": [1, 3]}`, true},
+		{"in other type", `{"key": "replicas", "op": "in", "value": ["3"]}`, false},
+		{"contains", `{"key": "tags", "op": "contains", "value": 2}`, true},
+		{"contains missing value", `{"key": "tags", "op": "contains", "value": "b"}`, false},
+		{"and", `{"and": [{"key": "name", "op": "exists"}, {"key": "replicas", "op": "gt", "value": 5}]}`, false},
+		{"or", `{"or": [{"key": "name", "op": "eq", "value": "x"}, {"key": "replicas", "op": "gt", "value": 2}]}`, true},
+		{"not", `{"not": {"key": "name", "op": "eq", "value": "x"}}`, true},
+		{"metadata height", `{"key": "$updateHeight", "op": "gt", "value": 15}`, true},
+		{"metadata tx hash", `{"key": "$txHash", "op": "eq", "value": "ABCD"}`, true},
+		{"metadata without expiry", `{"key": "$expiryHeight", "op": "exists"}`, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			filter := parseTestFilter(t, test.filter)
+			if err := filter.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			if filter.Matches(record, metadata) != test.matches {
+				t.Errorf("Matches = %v, want %v", !test.matches, test.matches)
+			}
+		})
+	}
+}
+
+func TestFilterJSON(t *testing.T) {
+	tests := []string{
+		`{"key":"a","op":"eq","value":3}`,
+		`{"key":"a","op":"eq","value":3.0}`,
+		`{"key":"a","op":"eq","value":{"/":{"bytes":"AQID"}}}`,
+		`{"key":"a","op":"null"}`,
+		`{"and":[{"key":"a","op":"in","value":[1,"x"]}],"not":{"key":"b","op":"exists"}}`,
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test, func(t *testing.T) {
+			bz, err := json.Marshal(parseTestFilter(t, test))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(bz) != test {
+				t.Errorf("filter JSON = %s, want %s", bz, test)
+			}
+		})
+	}
+}
+
+func TestFilterGetIndexedAttributes(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		want   map[string]interface{}
+	}{
+		{"eq", `{"key": "a", "op": "eq", "value": 1}`, map[string]interface{}{"a": int64(1)}},
+		{"null", `{"key": "a", "op": "null"}`, map[string]interface{}{"a": nil}},
+		{"and", `{"and": [{"key": "a", "op": "eq", "value": "x"}, {"key": "b", "op": "gt", "value": 1}]}`, map[string]interface{}{"a": "x"}},
+		{"or", `{"or": [{"key": "a", "op": "eq", "value": "x"}]}`, map[string]interface{}{}},
+		{"list value", `{"key": "a", "op": "eq", "value": [1]}`, map[string]interface{}{}},
+		{"metadata", `{"key": "$txHash", "op": "eq", "value": "ABCD"}`, map[string]interface{}{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			attrs := parseTestFilter(t, test.filter).GetIndexedAttributes()
+			if len(attrs) != len(test.want) {
+				t.Fatalf("GetIndexedAttributes = %v, want %v", attrs, test.want)
+			}
+
+			for key, value := range test.want {
+				actual, ok := attrs[key]
+				if !ok || !attributeValuesEqual(actual, value) {
+					t.Errorf("GetIndexedAttributes = %v, want %v", attrs, test.want)
+				}
+			}
+		})
+	}
+}
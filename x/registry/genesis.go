@@ -0,0 +1,313 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState represents the registry state at the start of the chain.
+// Indexes and the expiry queue aren't included, as they're rebuilt from the records and their metadata.
+// Heights of past writes are relative to the genesis height (i.e. zero or negative), and expiry heights are
+// the number of blocks remaining, so that they're kept when a chain is restarted from an export (at height 1).
+type GenesisState struct {
+	Params    Params          `json:"params"`
+	Records   []GenesisRecord `json:"records"`
+	Revisions []RevisionObj   `json:"revisions"`
+	Names     []GenesisName   `json:"names"`
+}
+
+// GenesisRecord represents a record, with its metadata.
+type GenesisRecord struct {
+	Record   RecordObj      `json:"record"`
+	Metadata RecordMetadata `json:"metadata"`
+}
+
+// GenesisName represents a reserved name.
+type GenesisName struct {
+	Name  string         `json:"name"`
+	Owner sdk.AccAddress `json:"owner"`
+	ID    ID             `json:"id"`
+}
+
+// DefaultGenesisState returns a registry genesis state with the default params, and no records or names.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:    DefaultParams(),
+		Records:   []GenesisRecord{},
+		Revisions: []RevisionObj{},
+		Names:     []GenesisName{},
+	}
+}
+
+// InitGenesis imports the registry state from genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, revision := range data.Revisions {
+		revision.Height += ctx.BlockHeight()
+		keeper.putRevision(ctx, revision)
+	}
+
+	for _, entry := range data.Records {
+		keeper.ImportResource(ctx, RecordObjToRecord(entry.Record), rebaseMetadata(entry.Metadata, ctx.BlockHeight()))
+	}
+
+	for _, name := range data.Names {
+		keeper.PutName(ctx, name.Name, NameRecord{Owner: name.Owner, ID: name.ID})
+	}
+}
+
+// ExportGenesis exports the registry state, in store key order (so that exports are deterministic),
+// with heights relative to the export height.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	data := DefaultGenesisState()
+	data.Params = keeper.GetParams(ctx)
+
+	for _, record := range keeper.ListResources(ctx) {
+		data.Records = append(data.Records, GenesisRecord{
+			Record:   RecordToRecordObj(record),
+			Metadata: rebaseMetadata(keeper.GetRecordMetadata(ctx, record.ID), -ctx.BlockHeight()),
+		})
+	}
+
+	keeper.IterateRevisions(ctx, func(revision RevisionObj) bool {
+		revision.Height -= ctx.BlockHeight()
+		data.Revisions = append(data.Revisions, revision)
+		return false
+	})
+
+	keeper.IterateNames(ctx, func(name string, nameRecord NameRecord) bool {
+		data.Names = append(data.Names, GenesisName{Name: name, Owner: nameRecord.Owner, ID: nameRecord.ID})
+		return false
+	})
+
+	return data
+}
+
+// ValidateGenesis checks that the registry genesis state is consistent, i.e. params and records are valid,
+// there are no duplicates, each record has revisions up to its version, records of a defined type match its schema,
+// and names and links point at known records. Records are known if they're in the genesis state, or have revisions
+// (i.e. they've been deleted or have expired). Links to unknown records are only allowed if the record was written
+// with dangling links allowed.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %s", err)
+	}
+
+	latestVersions := make(map[ID]uint64)
+	for _, revision := range data.Revisions {
+		id := revision.Record.ID
+		if id == "" {
+			return fmt.Errorf("revision %d has no record ID", revision.Version)
+		}
+
+		if revision.Version != latestVersions[id]+1 || revision.Record.Version != revision.Version {
+			return fmt.Errorf("revisions of record %s must be numbered consecutively from 1 (got %d)", id, revision.Version)
+		}
+
+		if revision.Height > 0 {
+			return fmt.Errorf("revision %d of record %s has a positive (not relative) height", revision.Version, id)
+		}
+
+		latestVersions[id] = revision.Version
+	}
+
+	records := make(map[ID]RecordObj)
+	for _, entry := range data.Records {
+		record := entry.Record
+		if err := validateGenesisRecord(record); err != nil {
+			return err
+		}
+
+		if _, exists := records[record.ID]; exists {
+			return fmt.Errorf("duplicate record %s", record.ID)
+		}
+		records[record.ID] = record
+
+		if record.Version != latestVersions[record.ID] {
+			return fmt.Errorf("record %s is at version %d, but its latest revision is %d", record.ID, record.Version, latestVersions[record.ID])
+		}
+
+		if err := validateGenesisMetadata(entry.Metadata); err != nil {
+			return fmt.Errorf("record %s: %s", record.ID, err)
+		}
+
+		if record.Type == TypeDefinitionType && entry.Metadata.ExpiryHeight > 0 {
+			return fmt.Errorf("type definition %s can't expire", record.ID)
+		}
+
+		if !entry.Metadata.Fee.IsValid() {
+			return fmt.Errorf("record %s has an invalid fee %s", record.ID, entry.Metadata.Fee)
+		}
+
+		if !entry.Metadata.FeeEscrow.IsValid() || !entry.Metadata.Fee.IsAllGTE(entry.Metadata.FeeEscrow) {
+			return fmt.Errorf("record %s has an invalid fee escrow %s", record.ID, entry.Metadata.FeeEscrow)
+		}
+	}
+
+	isKnownRecord := func(id ID) bool {
+		_, exists := records[id]
+		return exists || latestVersions[id] > 0
+	}
+
+	// Links and schemas are checked once all the records are known.
+	for _, entry := range data.Records {
+		record := entry.Record
+
+		if !entry.Metadata.DanglingLinks {
+			for _, link := range record.Links {
+				if !isKnownRecord(link.ID) {
+					return fmt.Errorf("record %s links to unknown record %s", record.ID, link.ID)
+				}
+			}
+		}
+
+		if err := validateGenesisRecordSchema(record, records); err != nil {
+			return fmt.Errorf("record %s doesn't match the schema of type %s: %s", record.ID, record.Type, err)
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, name := range data.Names {
+		if err := validateName(name.Name); err != nil {
+			return fmt.Errorf("invalid name %s: %v", name.Name, err.Data())
+		}
+
+		if names[name.Name] {
+			return fmt.Errorf("duplicate name %s", name.Name)
+		}
+		names[name.Name] = true
+
+		if name.Owner.Empty() {
+			return fmt.Errorf("name %s has no owner", name.Name)
+		}
+
+		if name.ID != "" && !isKnownRecord(name.ID) {
+			return fmt.Errorf("name %s points at unknown record %s", name.Name, name.ID)
+		}
+	}
+
+	return nil
+}
+
+// GetEscrowedCoins returns the record write fees held in escrow (to be refunded on delete) in the genesis state.
+func (data GenesisState) GetEscrowedCoins() sdk.Coins {
+	escrow := sdk.Coins{}
+	for _, entry := range data.Records {
+		escrow = escrow.Plus(entry.Metadata.FeeEscrow)
+	}
+
+	return escrow
+}
+
+// rebaseMetadata adds the offset to the heights in the record metadata (except the expiry height of records
+// that never expire).
+func rebaseMetadata(metadata RecordMetadata, offset int64) RecordMetadata {
+	metadata.CreateHeight += offset
+	metadata.UpdateHeight += offset
+	if metadata.ExpiryHeight > 0 {
+		metadata.ExpiryHeight += offset
+	}
+
+	// Copy the transfers, so that the caller's metadata isn't changed.
+	var transfers []OwnershipTransfer
+	for _, transfer := range metadata.Transfers {
+		transfer.Height += offset
+		transfers = append(transfers, transfer)
+	}
+	metadata.Transfers = transfers
+
+	return metadata
+}
+
+func validateGenesisMetadata(metadata RecordMetadata) error {
+	if metadata.CreateHeight > 0 || metadata.UpdateHeight > 0 {
+		return fmt.Errorf("write heights must be relative to the genesis height (zero or negative)")
+	}
+
+	for _, transfer := range metadata.Transfers {
+		if transfer.Height > 0 {
+			return fmt.Errorf("transfer heights must be relative to the genesis height (zero or negative)")
+		}
+	}
+
+	if metadata.ExpiryHeight < 0 {
+		return fmt.Errorf("negative expiry height (must be the number of blocks remaining)")
+	}
+
+	return nil
+}
+
+// validateGenesisRecordSchema checks that a record matches the schema of its type, if the type is defined by a type
+// definition in the genesis records.
+func validateGenesisRecordSchema(record RecordObj, records map[ID]RecordObj) error {
+	typeDef, exists := records[ID(record.Type)]
+	if !exists || typeDef.Type != TypeDefinitionType {
+		return nil
+	}
+
+	// Type definitions are validated before records (see validateGenesisRecord).
+	typeAttributes, err := UnMarshalAttributes(typeDef.Attributes)
+	if err != nil {
+		return err
+	}
+
+	schema, err := ParseSchema(typeAttributes)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := UnMarshalAttributes(record.Attributes)
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(attributes)
+}
+
+func validateGenesisRecord(record RecordObj) error {
+	if record.ID == "" {
+		return fmt.Errorf("record has no ID")
+	}
+
+	if strings.Contains(string(record.ID), VersionSeparator) {
+		return fmt.Errorf("record ID %s can't contain '%s'", record.ID, VersionSeparator)
+	}
+
+	if record.Owner == "" {
+		return fmt.Errorf("record %s has no owner", record.ID)
+	}
+
+	if err := validateRecordUTF8(record); err != nil {
+		return fmt.Errorf("record %s: %v", record.ID, err.Data())
+	}
+
+	if err := validateOwners(record); err != nil {
+		return fmt.Errorf("record %s: %v", record.ID, err.Data())
+	}
+
+	if record.TTL < 0 {
+		return fmt.Errorf("record %s has a negative TTL", record.ID)
+	}
+
+	attributes, err := UnMarshalAttributes(record.Attributes)
+	if err != nil {
+		return fmt.Errorf("record %s has invalid attributes: %s", record.ID, err)
+	}
+
+	if err := validateKeyComponents(record, attributes); err != nil {
+		return fmt.Errorf("record %s: %v", record.ID, err.Data())
+	}
+
+	if err := validateTypeDefinition(record); err != nil {
+		return fmt.Errorf("record %s is an invalid type definition: %s", record.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	testOwner1 = "02e840ed2d4c3e0b4e068f0d4be811b095ec78d5"
+	testOwner2 = "002aee66c9908426658a39d7e95a48646d172d0f"
+	testType   = "wrn:registry-type:service"
+)
+
+// setupTestGenesisState writes records (with a type definition, links, an expiry, a transfer and a deleted record)
+// and names, and exports them at height 10.
+func setupTestGenesisState(t *testing.T) (testInput, GenesisState) {
+	input := setupTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	input.putTestRecord(3, newTestRecord(testType, TypeDefinitionType, testOwner1, map[string]interface{}{
+		"fields": map[string]interface{}{"name": map[string]interface{}{"type": FieldTypeString, "required": true}},
+	}))
+
+	input.putTestRecord(4, newTestRecord("wrn:record:1", testType, testOwner1, map[string]interface{}{"name": "one"}))
+	input.putTestRecord(6, newTestRecord("wrn:record:1", testType, testOwner1, map[string]interface{}{"name": "one", "replicas": int64(2)}))
+	keeper.TransferResource(ctx.WithBlockHeight(8), Transfer{ID: "wrn:record:1", Version: 2, Owner: testOwner2})
+
+	record := newTestRecord("wrn:record:2", testType, testOwner2, map[string]interface{}{"name": "two", "load": 0.5})
+	record.Links = []Link{{ID: "wrn:record:1", Label: "depends"}}
+	input.putTestRecord(5, record)
+	keeper.SetRecordExpiry(ctx, "wrn:record:2", 110)
+
+	input.putTestRecord(5, newTestRecord("wrn:record:3", "wrn:registry-type:other", testOwner1, nil))
+	keeper.DeleteResource(ctx.WithBlockHeight(7), "wrn:record:3")
+
+	// Links to deleted records are known, and links to records that never existed are allowed if they were
+	// written with dangling links allowed.
+	record = newTestRecord("wrn:record:4", "wrn:registry-type:other", testOwner1, map[string]interface{}{"bytes": []byte{1, 2}})
+	record.Links = []Link{{ID: "wrn:record:3"}, {ID: "wrn:record:missing"}}
+	input.putTestRecord(9, record)
+	metadata := keeper.GetRecordMetadata(ctx, "wrn:record:4")
+	metadata.DanglingLinks = true
+	keeper.putRecordMetadata(ctx, "wrn:record:4", metadata)
+
+	owner := sdk.AccAddress(BytesFromHex(testOwner1))
+	keeper.PutName(ctx, "wrn://example/one", NameRecord{Owner: owner, ID: "wrn:record:1"})
+	keeper.PutName(ctx, "wrn://example/deleted", NameRecord{Owner: owner, ID: "wrn:record:3"})
+	keeper.PutName(ctx, "wrn://example/reserved", NameRecord{Owner: owner})
+
+	return input, ExportGenesis(ctx.WithBlockHeight(10), keeper)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input, data := setupTestGenesisState(t)
+
+	if len(data.Records) != 4 || len(data.Revisions) != 7 || len(data.Names) != 3 {
+		t.Fatalf("exported %d records, %d revisions and %d names, want 4, 7 and 3", len(data.Records), len(data.Revisions), len(data.Names))
+	}
+
+	if err := ValidateGenesis(data); err != nil {
+		t.Fatalf("exported genesis state is invalid: %s", err)
+	}
+
+	for _, entry := range data.Records {
+		if entry.Metadata.UpdateHeight > 0 || entry.Metadata.CreateHeight > 0 {
+			t.Errorf("record %s write heights aren't relative to the export height: %+v", entry.Record.ID, entry.Metadata)
+		}
+
+		if entry.Record.ID == "wrn:record:2" && entry.Metadata.ExpiryHeight != 100 {
+			t.Errorf("record %s expiry height = %d, want 100 blocks remaining", entry.Record.ID, entry.Metadata.ExpiryHeight)
+		}
+	}
+
+	// Import into a new chain (at height 1), and export again at the same (relative) height.
+	imported := setupTestInput(t)
+	InitGenesis(imported.ctx, imported.keeper, data)
+	reexported := ExportGenesis(imported.ctx, imported.keeper)
+
+	cdc := input.keeper.cdc
+	if expected, actual := cdc.MustMarshalJSON(data), cdc.MustMarshalJSON(reexported); string(expected) != string(actual) {
+		t.Errorf("re-exported genesis state differs:\n%s\nwant:\n%s", actual, expected)
+	}
+
+	// Indexes and the expiry queue are rebuilt on import.
+	ctx, keeper := imported.ctx, imported.keeper
+	if ids := getRecordIDs(keeper.MatchResources(ctx, RecordQuery{Type: testType, Owner: testOwner2})); len(ids) != 2 {
+		t.Errorf("imported records of type %s owned by %s = %v, want 2", testType, testOwner2, ids)
+	}
+
+	if ids := keeper.GetLinkingIDs(ctx, "wrn:record:1"); len(ids) != 1 || ids[0] != "wrn:record:2" {
+		t.Errorf("imported links to wrn:record:1 = %v, want [wrn:record:2]", ids)
+	}
+
+	if ids := keeper.GetExpiredRecords(ctx, 101); len(ids) != 1 || ids[0] != "wrn:record:2" {
+		t.Errorf("imported records expiring by height 101 = %v, want [wrn:record:2]", ids)
+	}
+
+	if ids := keeper.GetExpiredRecords(ctx, 100); len(ids) != 0 {
+		t.Errorf("imported records expiring by height 100 = %v, want none", ids)
+	}
+
+	if record, ok := keeper.ResolveName(ctx, "wrn://example/one"); !ok || record.Owner != testOwner2 {
+		t.Errorf("imported name doesn't resolve to the transferred record: %+v", record)
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	_, valid := setupTestGenesisState(t)
+
+	// Each test changes a copy of the valid genesis state.
+	tests := []struct {
+		name   string
+		change func(data *GenesisState)
+		valid  bool
+	}{
+		{"valid", func(data *GenesisState) {}, true},
+		{"default", func(data *GenesisState) { *data = DefaultGenesisState() }, true},
+		{"invalid params", func(data *GenesisState) { data.Params.MaxTTL = -1 }, false},
+		{"duplicate record", func(data *GenesisState) { data.Records = append(data.Records, data.Records[0]) }, false},
+		{"record version ahead of revisions", func(data *GenesisState) { data.Records[0].Record.Version++ }, false},
+		{"missing revision", func(data *GenesisState) { data.Revisions = data.Revisions[1:] }, false},
+		{"positive revision height", func(data *GenesisState) { data.Revisions[0].Height = 1 }, false},
+		{"positive write height", func(data *GenesisState) { data.Records[0].Metadata.UpdateHeight = 1 }, false},
+		{"negative expiry height", func(data *GenesisState) { data.Records[0].Metadata.ExpiryHeight = -1 }, false},
+		{"expiring type definition", func(data *GenesisState) {
+			setGenesisRecord(data, testType, func(entry *GenesisRecord) { entry.Metadata.ExpiryHeight = 5 })
+		}, false},
+		{"record without owner", func(data *GenesisState) { data.Records[0].Record.Owner = "" }, false},
+		{"invalid UTF-8", func(data *GenesisState) { data.Records[0].Record.Owners = []string{"\xff"} }, false},
+		{"invalid attributes", func(data *GenesisState) { data.Records[0].Record.Attributes = []byte("{") }, false},
+		{"schema mismatch", func(data *GenesisState) {
+			setGenesisRecord(data, "wrn:record:2", func(entry *GenesisRecord) { entry.Record.Attributes = []byte(`{"name":2}`) })
+		}, false},
+		{"schema without type definition", func(data *GenesisState) {
+			data.Records = removeGenesisRecord(data.Records, testType)
+			setGenesisRecord(data, "wrn:record:2", func(entry *GenesisRecord) { entry.Record.Attributes = []byte(`{"name":2}`) })
+		}, true},
+		{"link to unknown record", func(data *GenesisState) {
+			setGenesisRecord(data, "wrn:record:2", func(entry *GenesisRecord) { entry.Record.Links = []Link{{ID: "wrn:record:missing"}} })
+		}, false},
+		{"dangling link without flag", func(data *GenesisState) {
+			setGenesisRecord(data, "wrn:record:4", func(entry *GenesisRecord) { entry.Metadata.DanglingLinks = false })
+		}, false},
+		{"link to exported record", func(data *GenesisState) {
+			setGenesisRecord(data, "wrn:record:4", func(entry *GenesisRecord) {
+				entry.Record.Links = []Link{{ID: "wrn:record:1"}, {ID: "wrn:record:3"}}
+				entry.Metadata.DanglingLinks = false
+			})
+		}, true},
+		{"name of unknown record", func(data *GenesisState) { data.Names[0].ID = "wrn:record:missing" }, false},
+		{"duplicate name", func(data *GenesisState) { data.Names = append(data.Names, data.Names[0]) }, false},
+		{"invalid name", func(data *GenesisState) { data.Names[0].Name = "example/one" }, false},
+		{"name without owner", func(data *GenesisState) { data.Names[0].Owner = nil }, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data := copyGenesisState(valid)
+			test.change(&data)
+
+			err := ValidateGenesis(data)
+			if test.valid && err != nil {
+				t.Errorf("expected a valid genesis state, got %s", err)
+			}
+
+			if !test.valid && err == nil {
+				t.Error("expected an invalid genesis state")
+			}
+		})
+	}
+}
+
+// copyGenesisState copies the lists of a genesis state (and the record fields changed by tests), so that changes to
+// the copy don't change the original.
+func copyGenesisState(data GenesisState) GenesisState {
+	copied := data
+	copied.Records = append([]GenesisRecord{}, data.Records...)
+	copied.Revisions = append([]RevisionObj{}, data.Revisions...)
+	copied.Names = append([]GenesisName{}, data.Names...)
+
+	for index := range copied.Records {
+		copied.Records[index].Record.Links = append([]Link{}, data.Records[index].Record.Links...)
+	}
+
+	return copied
+}
+
+func setGenesisRecord(data *GenesisState, id ID, change func(entry *GenesisRecord)) {
+	for index := range data.Records {
+		if data.Records[index].Record.ID == id {
+			change(&data.Records[index])
+		}
+	}
+}
+
+func removeGenesisRecord(records []GenesisRecord, id ID) []GenesisRecord {
+	var result []GenesisRecord
+	for _, entry := range records {
+		if entry.Record.ID != id {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
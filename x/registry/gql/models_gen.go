@@ -2,6 +2,12 @@
 
 package gql
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type Account struct {
 	Address  string  `json:"address"`
 	PubKey   *string `json:"pubKey"`
@@ -10,6 +16,13 @@ type Account struct {
 	Balance  []Coin  `json:"balance"`
 }
 
+type Block struct {
+	Height BigUInt `json:"height"`
+	Hash   string  `json:"hash"`
+	Time   string  `json:"time"`
+	NumTxs int     `json:"numTxs"`
+}
+
 type Bot struct {
 	Record    *Record `json:"record"`
 	Name      string  `json:"name"`
@@ -21,6 +34,20 @@ type Coin struct {
 	Amount BigUInt `json:"amount"`
 }
 
+type FilterInput struct {
+	Key   *string       `json:"key"`
+	Op    *FilterOp     `json:"op"`
+	Value *ValueInput   `json:"value"`
+	And   []FilterInput `json:"and"`
+	Or    []FilterInput `json:"or"`
+	Not   *FilterInput  `json:"not"`
+}
+
+type HtlcParams struct {
+	MaxLocktime   int      `json:"maxLocktime"`
+	AllowedDenoms []string `json:"allowedDenoms"`
+}
+
 type KeyValue struct {
 	Key   string `json:"key"`
 	Value Value  `json:"value"`
@@ -31,31 +58,288 @@ type KeyValueInput struct {
 	Value ValueInput `json:"value"`
 }
 
+type Link struct {
+	ID    string  `json:"id"`
+	Label *string `json:"label"`
+}
+
+type MultisigParams struct {
+	MaxIDLength   int      `json:"maxIdLength"`
+	AllowedDenoms []string `json:"allowedDenoms"`
+}
+
+type OwnershipTransfer struct {
+	Height BigInt   `json:"height"`
+	TxHash string   `json:"txHash"`
+	From   []string `json:"from"`
+	To     []string `json:"to"`
+}
+
+type Params struct {
+	Registry RegistryParams `json:"registry"`
+	Htlc     HtlcParams     `json:"htlc"`
+	Multisig MultisigParams `json:"multisig"`
+	Utxo     UtxoParams     `json:"utxo"`
+}
+
 type Record struct {
-	ID         string      `json:"id"`
-	Type       string      `json:"type"`
-	Owner      string      `json:"owner"`
-	Attributes []*KeyValue `json:"attributes"`
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Owner      string          `json:"owner"`
+	Version    BigUInt         `json:"version"`
+	Owners     []string        `json:"owners"`
+	Threshold  int             `json:"threshold"`
+	Attributes []*KeyValue     `json:"attributes"`
+	Links      []*Link         `json:"links"`
+	Metadata   *RecordMetadata `json:"metadata"`
+}
+
+type RecordChange struct {
+	ID     string  `json:"id"`
+	Action string  `json:"action"`
+	Height BigUInt `json:"height"`
+	TxHash *string `json:"txHash"`
+	Record *Record `json:"record"`
+}
+
+type RecordMetadata struct {
+	CreateHeight BigInt              `json:"createHeight"`
+	CreateTime   string              `json:"createTime"`
+	CreateTxHash string              `json:"createTxHash"`
+	UpdateHeight BigInt              `json:"updateHeight"`
+	UpdateTime   string              `json:"updateTime"`
+	TxHash       string              `json:"txHash"`
+	ExpiryHeight *BigUInt            `json:"expiryHeight"`
+	Fee          []Coin              `json:"fee"`
+	FeePayer     *string             `json:"feePayer"`
+	Transfers    []OwnershipTransfer `json:"transfers"`
+}
+
+type RecordPage struct {
+	Records     []Record `json:"records"`
+	TotalCount  int      `json:"totalCount"`
+	EndCursor   *string  `json:"endCursor"`
+	HasNextPage bool     `json:"hasNextPage"`
+}
+
+type RecordRevision struct {
+	Version BigUInt `json:"version"`
+	Height  BigInt  `json:"height"`
+	TxHash  *string `json:"txHash"`
+	Record  Record  `json:"record"`
+}
+
+type RegistryParams struct {
+	MaxAttributeSize    int      `json:"maxAttributeSize"`
+	MaxRecordsPerOwner  int      `json:"maxRecordsPerOwner"`
+	AllowedTypePrefixes []string `json:"allowedTypePrefixes"`
+	MaxTTL              int      `json:"maxTtl"`
+	RentPerBlock        Coin     `json:"rentPerBlock"`
+	FeePerByte          Coin     `json:"feePerByte"`
+	FeeRefundRate       string   `json:"feeRefundRate"`
+}
+
+type SimulationResult struct {
+	GasWanted BigUInt  `json:"gasWanted"`
+	GasUsed   BigUInt  `json:"gasUsed"`
+	Log       string   `json:"log"`
+	Tags      []Tag    `json:"tags"`
+	Error     *TxError `json:"error"`
 }
 
 type Status struct {
 	Version string `json:"version"`
 }
 
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type TxError struct {
+	Codespace string `json:"codespace"`
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+}
+
+type TxResult struct {
+	Hash   string  `json:"hash"`
+	Height BigUInt `json:"height"`
+	Code   int     `json:"code"`
+	Log    string  `json:"log"`
+	Tags   []Tag   `json:"tags"`
+}
+
+type UtxoAccOutput struct {
+	ID      string  `json:"id"`
+	Address string  `json:"address"`
+	Value   BigUInt `json:"value"`
+	Height  BigUInt `json:"height"`
+	Spent   bool    `json:"spent"`
+}
+
+type UtxoOutPoint struct {
+	Hash    string  `json:"hash"`
+	Index   int     `json:"index"`
+	Address string  `json:"address"`
+	Value   BigUInt `json:"value"`
+}
+
+type UtxoParams struct {
+	MaxTxOutputs int `json:"maxTxOutputs"`
+}
+
+type UtxoTx struct {
+	Hash     string      `json:"hash"`
+	Inputs   []UtxoTxIn  `json:"inputs"`
+	Outputs  []UtxoTxOut `json:"outputs"`
+	LockTime BigUInt     `json:"lockTime"`
+}
+
+type UtxoTxIn struct {
+	Hash     string  `json:"hash"`
+	Index    int     `json:"index"`
+	Witness  string  `json:"witness"`
+	Sequence BigUInt `json:"sequence"`
+}
+
+type UtxoTxOut struct {
+	Index   int     `json:"index"`
+	Address string  `json:"address"`
+	Value   BigUInt `json:"value"`
+	Spent   bool    `json:"spent"`
+}
+
+type UtxoWallet struct {
+	Address string         `json:"address"`
+	Balance BigUInt        `json:"balance"`
+	Outputs []UtxoOutPoint `json:"outputs"`
+}
+
 type Value struct {
-	Null    *bool    `json:"null"`
-	Int     *int     `json:"int"`
-	Float   *float64 `json:"float"`
-	String  *string  `json:"string"`
-	Boolean *bool    `json:"boolean"`
-	Values  []*Value `json:"values"`
+	Null    *bool       `json:"null"`
+	Int     *int        `json:"int"`
+	BigInt  *string     `json:"bigInt"`
+	Float   *float64    `json:"float"`
+	String  *string     `json:"string"`
+	Boolean *bool       `json:"boolean"`
+	Bytes   *string     `json:"bytes"`
+	Values  []*Value    `json:"values"`
+	Map     []*KeyValue `json:"map"`
 }
 
 type ValueInput struct {
-	Null    *bool         `json:"null"`
-	Int     *int          `json:"int"`
-	Float   *float64      `json:"float"`
-	String  *string       `json:"string"`
-	Boolean *bool         `json:"boolean"`
-	Values  []*ValueInput `json:"values"`
+	Null    *bool            `json:"null"`
+	Int     *int             `json:"int"`
+	BigInt  *string          `json:"bigInt"`
+	Float   *float64         `json:"float"`
+	String  *string          `json:"string"`
+	Boolean *bool            `json:"boolean"`
+	Bytes   *string          `json:"bytes"`
+	Values  []*ValueInput    `json:"values"`
+	Map     []*KeyValueInput `json:"map"`
+}
+
+type FilterOp string
+
+const (
+	FilterOpEq        FilterOp = "EQ"
+	FilterOpGt        FilterOp = "GT"
+	FilterOpGte       FilterOp = "GTE"
+	FilterOpLt        FilterOp = "LT"
+	FilterOpLte       FilterOp = "LTE"
+	FilterOpPrefix    FilterOp = "PREFIX"
+	FilterOpSubstring FilterOp = "SUBSTRING"
+	FilterOpIn        FilterOp = "IN"
+	FilterOpExists    FilterOp = "EXISTS"
+	FilterOpNotExists FilterOp = "NOT_EXISTS"
+	FilterOpContains  FilterOp = "CONTAINS"
+)
+
+var AllFilterOp = []FilterOp{
+	FilterOpEq,
+	FilterOpGt,
+	FilterOpGte,
+	FilterOpLt,
+	FilterOpLte,
+	FilterOpPrefix,
+	FilterOpSubstring,
+	FilterOpIn,
+	FilterOpExists,
+	FilterOpNotExists,
+	FilterOpContains,
+}
+
+func (e FilterOp) IsValid() bool {
+	switch e {
+	case FilterOpEq, FilterOpGt, FilterOpGte, FilterOpLt, FilterOpLte, FilterOpPrefix, FilterOpSubstring, FilterOpIn, FilterOpExists, FilterOpNotExists, FilterOpContains:
+		return true
+	}
+	return false
+}
+
+func (e FilterOp) String() string {
+	return string(e)
+}
+
+func (e *FilterOp) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FilterOp(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FilterOp", str)
+	}
+	return nil
+}
+
+func (e FilterOp) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RecordOrderBy string
+
+const (
+	RecordOrderByID           RecordOrderBy = "ID"
+	RecordOrderByType         RecordOrderBy = "TYPE"
+	RecordOrderByOwner        RecordOrderBy = "OWNER"
+	RecordOrderByUpdateHeight RecordOrderBy = "UPDATE_HEIGHT"
+)
+
+var AllRecordOrderBy = []RecordOrderBy{
+	RecordOrderByID,
+	RecordOrderByType,
+	RecordOrderByOwner,
+	RecordOrderByUpdateHeight,
+}
+
+func (e RecordOrderBy) IsValid() bool {
+	switch e {
+	case RecordOrderByID, RecordOrderByType, RecordOrderByOwner, RecordOrderByUpdateHeight:
+		return true
+	}
+	return false
+}
+
+func (e RecordOrderBy) String() string {
+	return string(e)
+}
+
+func (e *RecordOrderBy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecordOrderBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecordOrderBy", str)
+	}
+	return nil
+}
+
+func (e RecordOrderBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
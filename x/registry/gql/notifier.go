@@ -0,0 +1,170 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/wirelineio/registry/x/registry"
+)
+
+// Number of committed blocks buffered for each subscriber, before it's dropped for not keeping up.
+const subscriberBufferSize = 100
+
+// Number of the latest committed blocks kept, to find txs committed just before a subscription
+// (which might not have been indexed yet).
+const recentBlockCount = 10
+
+// CommittedTx is the result of a tx included in a committed block.
+type CommittedTx struct {
+	Hash string // Upper case hex, as returned by submit.
+	Code uint32
+	Log  string
+	Tags []cmn.KVPair
+}
+
+// CommittedRecordChange is a change to a record made by a tx (or expiry) in a committed block.
+type CommittedRecordChange struct {
+	Action string
+	ID     registry.ID
+	TxHash *string // Nil for expired records.
+
+	// State of the record at the end of the block. If the record was deleted or has expired (Exists is false),
+	// Record is its latest revision and Metadata is empty.
+	Exists   bool
+	Record   registry.Record
+	Metadata registry.RecordMetadata
+}
+
+// CommittedBlock is a committed block, with the results of its txs, the end block tags and the records changed.
+type CommittedBlock struct {
+	Height        int64
+	Hash          string
+	Time          time.Time
+	Txs           []CommittedTx
+	EndBlockTags  []cmn.KVPair
+	RecordChanges []CommittedRecordChange
+}
+
+// CaptureRecordChanges adds the changes to records made by the txs (and expiry) of the block, with the state of
+// the records in the given context, which must be the context of the block after its end blocker has run.
+// Subscribers match records using this state, as the latest state might be from a later block.
+func (block *CommittedBlock) CaptureRecordChanges(ctx sdk.Context, keeper registry.Keeper) {
+	addChange := func(action string, id registry.ID, txHash *string) {
+		change := CommittedRecordChange{Action: action, ID: id, TxHash: txHash}
+
+		if keeper.HasResource(ctx, id) {
+			change.Exists = true
+			change.Record = keeper.GetResource(ctx, id)
+			change.Metadata = keeper.GetRecordMetadata(ctx, id)
+		} else {
+			version := keeper.GetLatestVersion(ctx, id)
+			if !keeper.HasRevision(ctx, id, version) {
+				return
+			}
+
+			change.Record = keeper.GetRevision(ctx, id, version).Record
+		}
+
+		block.RecordChanges = append(block.RecordChanges, change)
+	}
+
+	for _, tx := range block.Txs {
+		if tx.Code != uint32(sdk.CodeOK) {
+			continue
+		}
+
+		txHash := tx.Hash
+		for _, change := range getTaggedRecordChanges(tx.Tags) {
+			addChange(change.action, registry.ID(change.id), &txHash)
+		}
+	}
+
+	for _, tag := range block.EndBlockTags {
+		if string(tag.Key) == registry.TagExpiredRecord {
+			addChange(registry.TagExpiredRecord, registry.ID(tag.Value), nil)
+		}
+	}
+}
+
+// Notifier publishes committed blocks to GQL subscribers.
+type Notifier struct {
+	mtx         sync.Mutex
+	subscribers map[chan CommittedBlock]bool
+	recent      []CommittedBlock // The latest committed blocks, oldest first.
+}
+
+// NewNotifier creates a new Notifier.
+func NewNotifier() *Notifier {
+	return &Notifier{subscribers: make(map[chan CommittedBlock]bool)}
+}
+
+// Publish sends a committed block to the subscribers.
+// Subscribers that aren't keeping up are dropped (their channel is closed), so that block processing never waits on them.
+func (n *Notifier) Publish(block CommittedBlock) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.recent = append(n.recent, block)
+	if len(n.recent) > recentBlockCount {
+		n.recent = n.recent[len(n.recent)-recentBlockCount:]
+	}
+
+	for ch := range n.subscribers {
+		select {
+		case ch <- block:
+		default:
+			delete(n.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns a channel of committed blocks, which is closed once the context is done.
+func (n *Notifier) subscribe(ctx context.Context) <-chan CommittedBlock {
+	ch := make(chan CommittedBlock, subscriberBufferSize)
+
+	n.mtx.Lock()
+	n.subscribers[ch] = true
+	n.mtx.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		n.unsubscribe(ch)
+	}()
+
+	return ch
+}
+
+// findRecentTx returns the result of a tx in the latest committed blocks, and the height of its block.
+func (n *Notifier) findRecentTx(hash string) (CommittedTx, int64, bool) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	for _, block := range n.recent {
+		for _, tx := range block.Txs {
+			if strings.EqualFold(tx.Hash, hash) {
+				return tx, block.Height, true
+			}
+		}
+	}
+
+	return CommittedTx{}, 0, false
+}
+
+func (n *Notifier) unsubscribe(ch chan CommittedBlock) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if n.subscribers[ch] {
+		delete(n.subscribers, ch)
+		close(ch)
+	}
+}
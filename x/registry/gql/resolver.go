@@ -9,18 +9,22 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
-	"reflect"
+	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	abci "github.com/tendermint/tendermint/abci/types"
-	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
-	"github.com/tendermint/tendermint/rpc/core"
-	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/wirelineio/registry/x/htlc"
+	msighandler "github.com/wirelineio/registry/x/multisig/handlers"
 	"github.com/wirelineio/registry/x/registry"
+	"github.com/wirelineio/registry/x/utxo"
 )
 
 // WireRegistryTypeBot => Bot.
@@ -28,10 +32,14 @@ const WireRegistryTypeBot = "wrn:registry-type:bot"
 
 // Resolver is the GQL query resolver.
 type Resolver struct {
-	baseApp       *bam.BaseApp
-	codec         *codec.Codec
-	keeper        registry.Keeper
-	accountKeeper auth.AccountKeeper
+	baseApp        *bam.BaseApp
+	codec          *codec.Codec
+	keeper         registry.Keeper
+	accountKeeper  auth.AccountKeeper
+	htlcKeeper     htlc.Keeper
+	multisigKeeper msighandler.Keeper
+	utxoKeeper     utxo.Keeper
+	notifier       *Notifier
 }
 
 // Account resolver.
@@ -48,6 +56,34 @@ func (r *Resolver) Coin() CoinResolver {
 
 type coinResolver struct{ *Resolver }
 
+// Record resolver.
+func (r *Resolver) Record() RecordResolver {
+	return &recordResolver{r}
+}
+
+type recordResolver struct{ *Resolver }
+
+// RecordRevision resolver.
+func (r *Resolver) RecordRevision() RecordRevisionResolver {
+	return &recordRevisionResolver{r}
+}
+
+type recordRevisionResolver struct{ *Resolver }
+
+// RecordMetadata resolver.
+func (r *Resolver) RecordMetadata() RecordMetadataResolver {
+	return &recordMetadataResolver{r}
+}
+
+type recordMetadataResolver struct{ *Resolver }
+
+// OwnershipTransfer resolver.
+func (r *Resolver) OwnershipTransfer() OwnershipTransferResolver {
+	return &ownershipTransferResolver{r}
+}
+
+type ownershipTransferResolver struct{ *Resolver }
+
 // Mutation is the entry point to tx execution.
 func (r *Resolver) Mutation() MutationResolver {
 	return &mutationResolver{r}
@@ -65,6 +101,9 @@ type queryResolver struct{ *Resolver }
 // BigUInt represents a 64-bit unsigned integer.
 type BigUInt uint64
 
+// BigInt represents a 64-bit signed integer.
+type BigInt int64
+
 func (r *accountResolver) Number(ctx context.Context, obj *Account) (string, error) {
 	val := uint64(obj.Number)
 	return strconv.FormatUint(val, 10), nil
@@ -80,8 +119,47 @@ func (r *coinResolver) Amount(ctx context.Context, obj *Coin) (string, error) {
 	return strconv.FormatUint(val, 10), nil
 }
 
+func (r *recordResolver) Version(ctx context.Context, obj *Record) (string, error) {
+	val := uint64(obj.Version)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *recordRevisionResolver) Version(ctx context.Context, obj *RecordRevision) (string, error) {
+	val := uint64(obj.Version)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *recordRevisionResolver) Height(ctx context.Context, obj *RecordRevision) (string, error) {
+	val := int64(obj.Height)
+	return strconv.FormatInt(val, 10), nil
+}
+
+func (r *recordMetadataResolver) CreateHeight(ctx context.Context, obj *RecordMetadata) (string, error) {
+	val := int64(obj.CreateHeight)
+	return strconv.FormatInt(val, 10), nil
+}
+
+func (r *recordMetadataResolver) UpdateHeight(ctx context.Context, obj *RecordMetadata) (string, error) {
+	val := int64(obj.UpdateHeight)
+	return strconv.FormatInt(val, 10), nil
+}
+
+func (r *recordMetadataResolver) ExpiryHeight(ctx context.Context, obj *RecordMetadata) (*string, error) {
+	if obj.ExpiryHeight == nil {
+		return nil, nil
+	}
+
+	val := strconv.FormatUint(uint64(*obj.ExpiryHeight), 10)
+	return &val, nil
+}
+
+func (r *ownershipTransferResolver) Height(ctx context.Context, obj *OwnershipTransfer) (string, error) {
+	val := int64(obj.Height)
+	return strconv.FormatInt(val, 10), nil
+}
+
 func (r *mutationResolver) Submit(ctx context.Context, tx string) (*string, error) {
-	stdTx, err := decodeStdTx(tx)
+	stdTx, err := decodeStdTx(r.codec, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +202,27 @@ func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*R
 	return records, nil
 }
 
+func (r *queryResolver) ResolveNames(ctx context.Context, names []string) ([]*Record, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	records := make([]*Record, len(names))
+	for index, name := range names {
+		record, found := r.keeper.ResolveName(sdkContext, name)
+		if !found {
+			continue
+		}
+
+		gqlRecord, err := r.getGQLRecordWithMetadata(sdkContext, record)
+		if err != nil {
+			return nil, err
+		}
+
+		records[index] = gqlRecord
+	}
+
+	return records, nil
+}
+
 func (r *queryResolver) GetAccount(ctx context.Context, address string) (*Account, error) {
 	sdkContext := r.baseApp.NewContext(true, abci.Header{})
 
@@ -176,297 +275,593 @@ func (r *queryResolver) GetResource(ctx context.Context, id string) (*Record, er
 	dbID := registry.ID(id)
 	if r.keeper.HasResource(sdkContext, dbID) {
 		record := r.keeper.GetResource(sdkContext, dbID)
-		return getGQLRecord(record)
+		return r.getGQLRecordWithMetadata(sdkContext, record)
 	}
 
 	return nil, nil
 }
 
-func (r *queryResolver) GetRecordsByAttributes(ctx context.Context, attributes []*KeyValueInput) ([]*Record, error) {
+func (r *queryResolver) GetRecordRevision(ctx context.Context, id string, version string) (*RecordRevision, error) {
 	sdkContext := r.baseApp.NewContext(true, abci.Header{})
 
-	records := r.keeper.ListResources(sdkContext)
-	gqlResponse := []*Record{}
+	versionNum, err := strconv.ParseUint(version, 10, 64)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, record := range records {
-		gqlRecord, err := getGQLRecord(record)
+	dbID := registry.ID(id)
+	if r.keeper.HasRevision(sdkContext, dbID, versionNum) {
+		return getGQLRevision(r.keeper.GetRevision(sdkContext, dbID, versionNum))
+	}
+
+	return nil, nil
+}
+
+func (r *queryResolver) GetRecordHistory(ctx context.Context, id string) ([]*RecordRevision, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	revisions := r.keeper.ListRevisions(sdkContext, registry.ID(id))
+	gqlResponse := make([]*RecordRevision, len(revisions))
+
+	for index, revision := range revisions {
+		gqlRevision, err := getGQLRevision(revision)
 		if err != nil {
 			return nil, err
 		}
 
-		if matchesOnAttributes(&record, attributes) {
-			gqlResponse = append(gqlResponse, gqlRecord)
-		}
+		gqlResponse[index] = gqlRevision
 	}
 
 	return gqlResponse, nil
 }
 
-func matchesOnAttributes(record *registry.Record, attributes []*KeyValueInput) bool {
-	recAttrs := record.Attributes
+func (r *queryResolver) GetRecordGraph(ctx context.Context, id string, depth *int) ([]*Record, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
 
-	for _, attr := range attributes {
-		recAttrVal, recAttrFound := recAttrs[attr.Key]
-		if !recAttrFound {
-			return false
-		}
+	maxDepth := 0
+	if depth != nil {
+		maxDepth = *depth
+	}
 
-		if attr.Value.Int != nil {
-			recAttrValInt, ok := recAttrVal.(int)
-			if !ok || *attr.Value.Int != recAttrValInt {
-				return false
-			}
-		}
+	records := r.keeper.GetRecordGraph(sdkContext, registry.ID(id), maxDepth)
+	gqlResponse := make([]*Record, len(records))
 
-		if attr.Value.Float != nil {
-			recAttrValFloat, ok := recAttrVal.(float64)
-			if !ok || *attr.Value.Float != recAttrValFloat {
-				return false
-			}
+	for index, record := range records {
+		gqlRecord, err := r.getGQLRecordWithMetadata(sdkContext, record)
+		if err != nil {
+			return nil, err
 		}
 
-		if attr.Value.String != nil {
-			recAttrValString, ok := recAttrVal.(string)
-			if !ok || *attr.Value.String != recAttrValString {
-				return false
-			}
+		gqlResponse[index] = gqlRecord
+	}
+
+	return gqlResponse, nil
+}
+
+func (r *queryResolver) GetRecordsByAttributes(ctx context.Context, attributes []*KeyValueInput, filter *FilterInput,
+	first *int, after *string, orderBy *RecordOrderBy, descending *bool) ([]*Record, error) {
+	page, err := r.QueryRecords(ctx, nil, nil, attributes, filter, first, after, orderBy, descending)
+	if err != nil {
+		return nil, err
+	}
+
+	// This query doesn't return a cursor, so records beyond the default page size aren't dropped silently.
+	if first == nil && page.HasNextPage {
+		return nil, fmt.Errorf("more than %d records match, set first or use queryRecords to page through them", registry.DefaultPageSize)
+	}
+
+	gqlResponse := make([]*Record, len(page.Records))
+	for index := range page.Records {
+		gqlResponse[index] = &page.Records[index]
+	}
+
+	return gqlResponse, nil
+}
+
+func (r *queryResolver) QueryRecords(ctx context.Context, typeArg *string, owner *string, attributes []*KeyValueInput, filter *FilterInput,
+	first *int, after *string, orderBy *RecordOrderBy, descending *bool) (*RecordPage, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	recordFilter, err := getRecordFilter(attributes, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	query := registry.RecordQuery{Filter: recordFilter}
+	if typeArg != nil {
+		query.Type = *typeArg
+	}
+
+	if owner != nil {
+		query.Owner = *owner
+	}
+
+	// Matching records are only counted if the total count is requested, as that reads all of them.
+	pageRequest := getPageRequest(first, after, orderBy, descending)
+	for _, field := range graphql.CollectFieldsCtx(ctx, []string{"RecordPage"}) {
+		if field.Name == "totalCount" {
+			pageRequest.Count = true
 		}
+	}
 
-		if attr.Value.Boolean != nil {
-			recAttrValBool, ok := recAttrVal.(bool)
-			if !ok || *attr.Value.Boolean != recAttrValBool {
-				return false
-			}
+	page, err := r.keeper.QueryResources(sdkContext, query, pageRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	gqlPage := &RecordPage{
+		Records:     make([]Record, len(page.Records)),
+		HasNextPage: page.HasNextPage,
+	}
+
+	if page.TotalCount != nil {
+		gqlPage.TotalCount = *page.TotalCount
+	}
+
+	if page.EndCursor != "" {
+		gqlPage.EndCursor = &page.EndCursor
+	}
+
+	for index, record := range page.Records {
+		gqlRecord, err := r.getGQLRecordWithMetadata(sdkContext, record)
+		if err != nil {
+			return nil, err
 		}
 
-		// TODO(ashwin): Handle arrays.
+		gqlPage.Records[index] = *gqlRecord
 	}
 
-	return true
+	return gqlPage, nil
 }
 
-func getGQLRecord(record registry.Record) (*Record, error) {
-	// systemAttrs, err := mapToJSONStr(record.SystemAttributes)
-	// if err != nil {
-	// 	return nil, err
-	// }
+// recordOrderings maps GQL record orderings to registry record orderings.
+var recordOrderings = map[RecordOrderBy]string{
+	RecordOrderByID:           registry.OrderByID,
+	RecordOrderByType:         registry.OrderByType,
+	RecordOrderByOwner:        registry.OrderByOwner,
+	RecordOrderByUpdateHeight: registry.OrderByUpdateHeight,
+}
 
-	attrs, err := mapToKeyValuePairs(record.Attributes)
+func getPageRequest(first *int, after *string, orderBy *RecordOrderBy, descending *bool) registry.PageRequest {
+	page := registry.PageRequest{}
+
+	if first != nil {
+		page.First = *first
+	}
+
+	if after != nil {
+		page.After = *after
+	}
+
+	if orderBy != nil {
+		page.OrderBy = recordOrderings[*orderBy]
+	}
+
+	if descending != nil {
+		page.Descending = *descending
+	}
+
+	return page.WithDefaultPageSize()
+}
+
+// filterOps maps GQL filter operators to registry filter operators.
+var filterOps = map[FilterOp]string{
+	FilterOpEq:        registry.FilterOpEq,
+	FilterOpGt:        registry.FilterOpGt,
+	FilterOpGte:       registry.FilterOpGte,
+	FilterOpLt:        registry.FilterOpLt,
+	FilterOpLte:       registry.FilterOpLte,
+	FilterOpPrefix:    registry.FilterOpPrefix,
+	FilterOpSubstring: registry.FilterOpSubstring,
+	FilterOpIn:        registry.FilterOpIn,
+	FilterOpExists:    registry.FilterOpExists,
+	FilterOpNotExists: registry.FilterOpNotExists,
+	FilterOpContains:  registry.FilterOpContains,
+}
+
+// filterInputToFilter converts a GQL filter input to a (validated) registry filter.
+func filterInputToFilter(input *FilterInput) (*registry.Filter, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	filter, err := toRegistryFilter(input)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Record{
-		ID:         string(record.ID),
-		Type:       record.Type,
-		Owner:      record.Owner,
-		Attributes: attrs,
-	}, nil
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
 }
 
-func mapToKeyValuePairs(attrs map[string]interface{}) ([]*KeyValue, error) {
-	kvPairs := []*KeyValue{}
+func toRegistryFilter(input *FilterInput) (*registry.Filter, error) {
+	filter := &registry.Filter{}
 
-	trueVal := true
-	falseVal := false
+	if input.Key != nil {
+		filter.Key = *input.Key
+	}
 
-	for key, value := range attrs {
+	if input.Op != nil {
+		filter.Op = filterOps[*input.Op]
+	}
 
-		kvPair := &KeyValue{
-			Key: key,
+	if input.Value != nil {
+		value, ok, err := valueInputToAttributeValue(input.Value)
+		if err != nil {
+			return nil, err
 		}
 
-		switch val := value.(type) {
-		case nil:
-			kvPair.Value.Null = &trueVal
-		case int:
-			kvPair.Value.Int = &val
-		case float64:
-			kvPair.Value.Float = &val
-		case string:
-			kvPair.Value.String = &val
-		case bool:
-			kvPair.Value.Boolean = &val
-		}
+		filter.Value = value
 
-		if kvPair.Value.Null == nil {
-			kvPair.Value.Null = &falseVal
+		// Null values are matched using the null op, as eq requires a value.
+		if ok && value == nil && filter.Op == registry.FilterOpEq {
+			filter.Op = registry.FilterOpNull
 		}
+	}
+
+	var err error
+	if filter.And, err = toRegistryFilters(input.And); err != nil {
+		return nil, err
+	}
+
+	if filter.Or, err = toRegistryFilters(input.Or); err != nil {
+		return nil, err
+	}
 
-		valueType := reflect.ValueOf(value)
-		if valueType.Kind() == reflect.Slice {
-			// TODO(ashwin): Handle arrays.
+	if input.Not != nil {
+		notFilter, err := toRegistryFilter(input.Not)
+		if err != nil {
+			return nil, err
 		}
 
-		kvPairs = append(kvPairs, kvPair)
+		filter.Not = notFilter
 	}
 
-	return kvPairs, nil
+	return filter, nil
 }
 
-func mapToJSONStr(attrs map[string]interface{}) (*string, error) {
-	if len(attrs) == 0 {
+func toRegistryFilters(inputs []FilterInput) ([]registry.Filter, error) {
+	if inputs == nil {
 		return nil, nil
 	}
 
-	attrsJSON, err := json.Marshal(attrs)
-	if err != nil {
-		return nil, err
-	}
+	filters := []registry.Filter{}
+	for index := range inputs {
+		filter, err := toRegistryFilter(&inputs[index])
+		if err != nil {
+			return nil, err
+		}
 
-	attrsJSONStr := string(attrsJSON)
+		filters = append(filters, *filter)
+	}
 
-	return &attrsJSONStr, nil
+	return filters, nil
 }
 
-func decodeStdTx(tx string) (*auth.StdTx, error) {
-	bytes, err := base64.StdEncoding.DecodeString(tx)
+// getRecordFilter returns a (validated) registry filter that matches records with each of the attributes,
+// and the filter (if any). Attribute inputs without a value only check that the attribute exists.
+func getRecordFilter(attributes []*KeyValueInput, input *FilterInput) (*registry.Filter, error) {
+	filter, err := filterInputToFilter(input)
 	if err != nil {
 		return nil, err
 	}
 
-	// Note: json.Unmarshal doesn't known which Msg struct to use, so we do it "manually".
-	// See https://stackoverflow.com/questions/11066946/partly-json-unmarshal-into-a-map-in-go
-	var objmap map[string]*json.RawMessage
-	err = json.Unmarshal(bytes, &objmap)
-	if err != nil {
-		return nil, err
+	if len(attributes) == 0 {
+		return filter, nil
 	}
 
-	var operationStr = "set"
-	if objmap["operation"] != nil {
-		err = json.Unmarshal(*objmap["operation"], &operationStr)
+	conditions := []registry.Filter{}
+	for _, attr := range attributes {
+		value, ok, err := valueInputToAttributeValue(&attr.Value)
 		if err != nil {
 			return nil, err
 		}
+
+		condition := registry.Filter{Key: attr.Key, Op: registry.FilterOpExists}
+		switch {
+		case ok && value == nil:
+			condition.Op = registry.FilterOpNull
+		case ok:
+			condition.Op = registry.FilterOpEq
+			condition.Value = value
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	if filter != nil {
+		conditions = append(conditions, *filter)
 	}
 
-	var msgs []sdk.Msg
+	recordFilter := &registry.Filter{And: conditions}
+	if err := recordFilter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return recordFilter, nil
+}
+
+// valueInputToAttributeValue converts a GQL input value to a typed attribute value (see registry.UnMarshalAttributes).
+// Returns false if no value is set.
+func valueInputToAttributeValue(input *ValueInput) (interface{}, bool, error) {
+	switch {
+	case input.Null != nil && *input.Null:
+		return nil, true, nil
+	case input.Int != nil:
+		return int64(*input.Int), true, nil
+	case input.BigInt != nil:
+		num, err := strconv.ParseInt(*input.BigInt, 10, 64)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid bigInt value %s", *input.BigInt)
+		}
 
-	switch operationStr {
-	case "set":
-		{
-			var setMsg []registry.MsgSetRecord
-			err = json.Unmarshal(*objmap["msg"], &setMsg)
+		return num, true, nil
+	case input.Float != nil:
+		return *input.Float, true, nil
+	case input.String != nil:
+		return *input.String, true, nil
+	case input.Boolean != nil:
+		return *input.Boolean, true, nil
+	case input.Bytes != nil:
+		bytes, err := base64.StdEncoding.DecodeString(*input.Bytes)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return bytes, true, nil
+	case input.Values != nil:
+		values := make([]interface{}, len(input.Values))
+		for index, item := range input.Values {
+			if item == nil {
+				continue
+			}
+
+			value, _, err := valueInputToAttributeValue(item)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
-			msgs = []sdk.Msg{setMsg[0]}
+
+			values[index] = value
 		}
-	case "delete":
-		{
-			var deleteMsg []registry.MsgDeleteRecord
-			err = json.Unmarshal(*objmap["msg"], &deleteMsg)
+
+		return values, true, nil
+	case input.Map != nil:
+		values := make(map[string]interface{})
+		for _, item := range input.Map {
+			value, _, err := valueInputToAttributeValue(&item.Value)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
-			msgs = []sdk.Msg{deleteMsg[0]}
+
+			values[item.Key] = value
 		}
-	}
 
-	var fee auth.StdFee
-	err = json.Unmarshal(*objmap["fee"], &fee)
-	if err != nil {
-		return nil, err
+		return values, true, nil
 	}
 
-	var sigs []*json.RawMessage
-	err = json.Unmarshal(*objmap["signatures"], &sigs)
+	return nil, false, nil
+}
+
+// getGQLRecordWithMetadata converts a (current) record, including its metadata.
+func (r *Resolver) getGQLRecordWithMetadata(ctx sdk.Context, record registry.Record) (*Record, error) {
+	metadata := r.keeper.GetRecordMetadata(ctx, record.ID)
+	return getGQLRecord(record, &metadata)
+}
+
+func getGQLRecord(record registry.Record, metadata *registry.RecordMetadata) (*Record, error) {
+	attrs, err := mapToKeyValuePairs(record.Attributes)
 	if err != nil {
 		return nil, err
 	}
 
-	var sig map[string]*json.RawMessage
-	err = json.Unmarshal(*sigs[0], &sig)
-	if err != nil {
-		return nil, err
+	return &Record{
+		ID:         string(record.ID),
+		Type:       record.Type,
+		Owner:      record.Owner,
+		Version:    BigUInt(record.Version),
+		Owners:     record.Owners,
+		Threshold:  record.GetThreshold(),
+		Attributes: attrs,
+		Links:      getGQLLinks(record.Links),
+		Metadata:   getGQLRecordMetadata(metadata),
+	}, nil
+}
+
+func getGQLRecordMetadata(metadata *registry.RecordMetadata) *RecordMetadata {
+	if metadata == nil {
+		return nil
 	}
 
-	var pubKeyStr string
-	err = json.Unmarshal(*sig["pub_key"], &pubKeyStr)
-	if err != nil {
-		return nil, err
+	// Records that never expire don't have an expiry height.
+	var expiryHeight *BigUInt
+	if metadata.ExpiryHeight > 0 {
+		height := BigUInt(metadata.ExpiryHeight)
+		expiryHeight = &height
 	}
 
-	pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKeyStr)
-	if err != nil {
-		return nil, err
+	transfers := make([]OwnershipTransfer, len(metadata.Transfers))
+	for index, transfer := range metadata.Transfers {
+		transfers[index] = OwnershipTransfer{
+			Height: BigInt(transfer.Height),
+			TxHash: transfer.TxHash,
+			From:   transfer.From,
+			To:     transfer.To,
+		}
 	}
 
-	pubKey, err := cryptoAmino.PubKeyFromBytes(pubKeyBytes)
-	if err != nil {
-		return nil, err
+	fee := make([]Coin, len(metadata.Fee))
+	for index, coin := range metadata.Fee {
+		fee[index] = Coin{Type: coin.Denom, Amount: BigUInt(coin.Amount.Int64())}
 	}
 
-	var signature []byte
-	err = json.Unmarshal(*sig["signature"], &signature)
-	if err != nil {
-		return nil, err
+	var feePayer *string
+	if !metadata.FeePayer.Empty() {
+		address := metadata.FeePayer.String()
+		feePayer = &address
 	}
 
-	var accountNum uint64
-	err = json.Unmarshal(*sig["account_number"], &accountNum)
-	if err != nil {
-		return nil, err
+	return &RecordMetadata{
+		CreateHeight: BigInt(metadata.CreateHeight),
+		CreateTime:   metadata.CreateTime.UTC().Format(time.RFC3339),
+		CreateTxHash: metadata.CreateTxHash,
+		UpdateHeight: BigInt(metadata.UpdateHeight),
+		UpdateTime:   metadata.UpdateTime.UTC().Format(time.RFC3339),
+		TxHash:       metadata.TxHash,
+		ExpiryHeight: expiryHeight,
+		Fee:          fee,
+		FeePayer:     feePayer,
+		Transfers:    transfers,
 	}
+}
 
-	var sequenceNum uint64
-	err = json.Unmarshal(*sig["sequence"], &sequenceNum)
-	if err != nil {
-		return nil, err
+func getGQLLinks(links []registry.Link) []*Link {
+	gqlLinks := make([]*Link, len(links))
+	for index, link := range links {
+		// Labels are optional.
+		var label *string
+		if link.Label != "" {
+			label = &links[index].Label
+		}
+
+		gqlLinks[index] = &Link{
+			ID:    string(link.ID),
+			Label: label,
+		}
 	}
 
-	var memo string
-	err = json.Unmarshal(*objmap["memo"], &memo)
+	return gqlLinks
+}
+
+func getGQLRevision(revision registry.Revision) (*RecordRevision, error) {
+	record, err := getGQLRecord(revision.Record, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	stdTx := auth.StdTx{
-		Msgs: msgs,
-		Fee:  fee,
-		Signatures: []auth.StdSignature{auth.StdSignature{
-			PubKey:        pubKey,
-			Signature:     signature,
-			AccountNumber: accountNum,
-			Sequence:      sequenceNum,
-		}},
-		Memo: memo,
+	var txHash *string
+	if revision.TxHash != "" {
+		txHash = &revision.TxHash
 	}
 
-	return &stdTx, nil
+	return &RecordRevision{
+		Version: BigUInt(revision.Version),
+		Height:  BigInt(revision.Height),
+		TxHash:  txHash,
+		Record:  *record,
+	}, nil
 }
 
-func broadcastTx(r *mutationResolver, stdTx *auth.StdTx) (*ctypes.ResultBroadcastTxCommit, error) {
-	txBytes, err := r.Resolver.codec.MarshalBinaryLengthPrefixed(stdTx)
-	if err != nil {
-		return nil, err
+func mapToKeyValuePairs(attrs map[string]interface{}) ([]*KeyValue, error) {
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
 
-	res, err := core.BroadcastTxCommit(txBytes)
-	if err != nil {
-		return nil, err
+	kvPairs := []*KeyValue{}
+
+	for _, key := range keys {
+		value, err := toGQLValue(attrs[key])
+		if err != nil {
+			return nil, err
+		}
+
+		kvPairs = append(kvPairs, &KeyValue{
+			Key:   key,
+			Value: *value,
+		})
 	}
 
-	if res.CheckTx.IsErr() {
-		return nil, errors.New(res.CheckTx.String())
+	return kvPairs, nil
+}
+
+// toGQLValue converts a typed attribute value (see registry.UnMarshalAttributes) to a GQL value.
+func toGQLValue(value interface{}) (*Value, error) {
+	trueVal := true
+	falseVal := false
+
+	gqlValue := &Value{Null: &falseVal}
+
+	switch val := value.(type) {
+	case nil:
+		gqlValue.Null = &trueVal
+	case int64:
+		// GQL Int is 32-bit.
+		if val >= math.MinInt32 && val <= math.MaxInt32 {
+			num := int(val)
+			gqlValue.Int = &num
+		}
+
+		bigInt := strconv.FormatInt(val, 10)
+		gqlValue.BigInt = &bigInt
+	case float64:
+		gqlValue.Float = &val
+	case string:
+		gqlValue.String = &val
+	case bool:
+		gqlValue.Boolean = &val
+	case []byte:
+		bytes := base64.StdEncoding.EncodeToString(val)
+		gqlValue.Bytes = &bytes
+	case []interface{}:
+		gqlValue.Values = []*Value{}
+		for _, item := range val {
+			itemValue, err := toGQLValue(item)
+			if err != nil {
+				return nil, err
+			}
+
+			gqlValue.Values = append(gqlValue.Values, itemValue)
+		}
+	case map[string]interface{}:
+		kvPairs, err := mapToKeyValuePairs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		gqlValue.Map = kvPairs
+	default:
+		return nil, fmt.Errorf("unsupported attribute value type %T", value)
+	}
+
+	return gqlValue, nil
+}
+
+func mapToJSONStr(attrs map[string]interface{}) (*string, error) {
+	if len(attrs) == 0 {
+		return nil, nil
 	}
 
-	if res.DeliverTx.IsErr() {
-		return nil, errors.New(res.DeliverTx.String())
+	attrsJSON, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
 	}
 
-	return res, nil
+	attrsJSONStr := string(attrsJSON)
+
+	return &attrsJSONStr, nil
 }
 
-func (r *queryResolver) GetBotsByAttributes(ctx context.Context, attributes []*KeyValueInput) ([]*Bot, error) {
+func (r *queryResolver) GetBotsByAttributes(ctx context.Context, attributes []*KeyValueInput, filter *FilterInput) ([]*Bot, error) {
 	bots := []*Bot{}
 
 	sdkContext := r.baseApp.NewContext(true, abci.Header{})
 
-	records := r.keeper.ListResources(sdkContext)
+	recordFilter, err := getRecordFilter(attributes, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	records := r.keeper.MatchResources(sdkContext, registry.RecordQuery{
+		Type:   WireRegistryTypeBot,
+		Filter: recordFilter,
+	})
+
 	for _, record := range records {
-		if record.Type == WireRegistryTypeBot && record.Attributes != nil {
+		if record.Attributes != nil {
 			// Name is mandatory.
 			if name, ok := record.Attributes["name"].(string); ok {
 
@@ -477,19 +872,16 @@ func (r *queryResolver) GetBotsByAttributes(ctx context.Context, attributes []*K
 					accessKeyVal = &accessKey
 				}
 
-				if matchesOnAttributes(&record, attributes) {
-					res, err := getGQLRecord(record)
-					if err != nil {
-						return nil, err
-					}
-
-					bots = append(bots, &Bot{
-						Record:    res,
-						Name:      name,
-						AccessKey: accessKeyVal,
-					})
+				res, err := r.getGQLRecordWithMetadata(sdkContext, record)
+				if err != nil {
+					return nil, err
 				}
 
+				bots = append(bots, &Bot{
+					Record:    res,
+					Name:      name,
+					AccessKey: accessKeyVal,
+				})
 			}
 		}
 	}
@@ -502,3 +894,50 @@ func (r *queryResolver) GetBotsByAttributes(ctx context.Context, attributes []*K
 func (r *queryResolver) GetStatus(ctx context.Context) (*Status, error) {
 	return &Status{Version: RegistryVersion}, nil
 }
+
+func (r *queryResolver) GetParams(ctx context.Context) (*Params, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	registryParams := r.keeper.GetParams(sdkContext)
+	htlcParams := r.htlcKeeper.GetParams(sdkContext)
+	multisigParams := r.multisigKeeper.GetParams(sdkContext)
+	utxoParams := r.utxoKeeper.GetParams(sdkContext)
+
+	rent := registryParams.RentPerBlock
+	feePerByte := registryParams.FeePerByte
+	if !rent.IsNotNegative() || !feePerByte.IsNotNegative() {
+		return nil, errors.New("amount cannot be negative")
+	}
+
+	return &Params{
+		Registry: RegistryParams{
+			MaxAttributeSize:    int(registryParams.MaxAttributeSize),
+			MaxRecordsPerOwner:  int(registryParams.MaxRecordsPerOwner),
+			AllowedTypePrefixes: nonNilStrings(registryParams.AllowedTypePrefixes),
+			MaxTTL:              int(registryParams.MaxTTL),
+			RentPerBlock:        Coin{Type: rent.Denom, Amount: BigUInt(rent.Amount.Int64())},
+			FeePerByte:          Coin{Type: feePerByte.Denom, Amount: BigUInt(feePerByte.Amount.Int64())},
+			FeeRefundRate:       registryParams.FeeRefundRate.String(),
+		},
+		Htlc: HtlcParams{
+			MaxLocktime:   int(htlcParams.MaxLocktime),
+			AllowedDenoms: nonNilStrings(htlcParams.AllowedDenoms),
+		},
+		Multisig: MultisigParams{
+			MaxIDLength:   int(multisigParams.MaxIDLength),
+			AllowedDenoms: nonNilStrings(multisigParams.AllowedDenoms),
+		},
+		Utxo: UtxoParams{
+			MaxTxOutputs: int(utxoParams.MaxTxOutputs),
+		},
+	}, nil
+}
+
+// nonNilStrings returns an empty list instead of nil, for non-null GQL lists.
+func nonNilStrings(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+
+	return list
+}
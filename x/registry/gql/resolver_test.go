@@ -0,0 +1,76 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"testing"
+
+	"github.com/wirelineio/registry/x/registry"
+)
+
+func TestGetRecordFilter(t *testing.T) {
+	attributes, err := registry.UnMarshalAttributes([]byte(`{"name": "weather", "replicas": 3, "region": null}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := registry.Record{ID: "wrn:record:1", Type: "wrn:registry-type:service", Attributes: attributes}
+
+	name, region, missing := "name", "region", "missing"
+	eq, in, exists := FilterOpEq, FilterOpIn, FilterOpExists
+	str, other, null, three := "weather", "other", true, 3
+
+	inValues := []*ValueInput{}
+	for index := 0; index <= registry.MaxFilterInValues; index++ {
+		inValues = append(inValues, &ValueInput{Int: &three})
+	}
+
+	tests := []struct {
+		name       string
+		attributes []*KeyValueInput
+		filter     *FilterInput
+		valid      bool
+		matches    bool
+	}{
+		{"no filter", nil, nil, true, true},
+		{"attribute", []*KeyValueInput{{Key: name, Value: ValueInput{String: &str}}}, nil, true, true},
+		{"attribute mismatch", []*KeyValueInput{{Key: name, Value: ValueInput{String: &other}}}, nil, true, false},
+		{"int attribute", []*KeyValueInput{{Key: "replicas", Value: ValueInput{Int: &three}}}, nil, true, true},
+		{"null attribute", []*KeyValueInput{{Key: region, Value: ValueInput{Null: &null}}}, nil, true, true},
+		{"null attribute set", []*KeyValueInput{{Key: name, Value: ValueInput{Null: &null}}}, nil, true, false},
+		{"attribute without value", []*KeyValueInput{{Key: missing}}, nil, true, false},
+		{"eq null", nil, &FilterInput{Key: &region, Op: &eq, Value: &ValueInput{Null: &null}}, true, true},
+		{"eq null set", nil, &FilterInput{Key: &name, Op: &eq, Value: &ValueInput{Null: &null}}, true, false},
+		{"eq without value", nil, &FilterInput{Key: &name, Op: &eq}, false, false},
+		{"exists", nil, &FilterInput{Key: &region, Op: &exists}, true, true},
+		{"in", nil, &FilterInput{Key: &name, Op: &in, Value: &ValueInput{Values: []*ValueInput{{String: &other}, {String: &str}}}}, true, true},
+		{"in over limit", nil, &FilterInput{Key: &name, Op: &in, Value: &ValueInput{Values: inValues}}, false, false},
+		{"attributes and filter", []*KeyValueInput{{Key: name, Value: ValueInput{String: &str}}},
+			&FilterInput{Not: &FilterInput{Key: &region, Op: &exists}}, true, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := getRecordFilter(test.attributes, test.filter)
+			if !test.valid {
+				if err == nil {
+					t.Fatal("expected an invalid filter")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			matches := filter == nil || filter.Matches(record, registry.RecordMetadata{})
+			if matches != test.matches {
+				t.Errorf("filter matches = %v, want %v", matches, test.matches)
+			}
+		})
+	}
+}
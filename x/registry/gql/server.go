@@ -13,16 +13,21 @@ import (
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/wirelineio/registry/x/htlc"
+	msighandler "github.com/wirelineio/registry/x/multisig/handlers"
 	"github.com/wirelineio/registry/x/registry"
+	"github.com/wirelineio/registry/x/utxo"
 
 	"github.com/go-chi/chi"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 )
 
 const defaultPort = "9473"
 
 // Server configures and starts the GQL server.
-func Server(baseApp *bam.BaseApp, cdc *codec.Codec, keeper registry.Keeper, accountKeeper auth.AccountKeeper) {
+func Server(baseApp *bam.BaseApp, cdc *codec.Codec, keeper registry.Keeper, accountKeeper auth.AccountKeeper,
+	htlcKeeper htlc.Keeper, multisigKeeper msighandler.Keeper, utxoKeeper utxo.Keeper, notifier *Notifier) {
 	if viper.GetBool("gql-server") {
 		port := viper.GetString("gql-port")
 		if port == "" {
@@ -45,20 +50,26 @@ func Server(baseApp *bam.BaseApp, cdc *codec.Codec, keeper registry.Keeper, acco
 			router.Handle("/", handler.Playground("Wireline Registry", "/query"))
 		}
 
-		router.Handle("/graphql", handler.GraphQL(NewExecutableSchema(Config{Resolvers: &Resolver{
-			baseApp:       baseApp,
-			codec:         cdc,
-			keeper:        keeper,
-			accountKeeper: accountKeeper,
-		}})))
+		resolver := &Resolver{
+			baseApp:        baseApp,
+			codec:          cdc,
+			keeper:         keeper,
+			accountKeeper:  accountKeeper,
+			htlcKeeper:     htlcKeeper,
+			multisigKeeper: multisigKeeper,
+			utxoKeeper:     utxoKeeper,
+			notifier:       notifier,
+		}
+
+		// Subscriptions are served over websocket, from any origin (like queries, see CORS above).
+		upgrader := handler.WebsocketUpgrader(websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true },
+		})
+
+		router.Handle("/graphql", handler.GraphQL(NewExecutableSchema(Config{Resolvers: resolver}), upgrader))
 
 		// TODO(ashwin): Kept for backward compat. Remove after migration to /graphql for GQL endpoint is complete.
-		router.Handle("/query", handler.GraphQL(NewExecutableSchema(Config{Resolvers: &Resolver{
-			baseApp:       baseApp,
-			codec:         cdc,
-			keeper:        keeper,
-			accountKeeper: accountKeeper,
-		}})))
+		router.Handle("/query", handler.GraphQL(NewExecutableSchema(Config{Resolvers: resolver}), upgrader))
 
 		err := http.ListenAndServe(":"+port, router)
 		if err != nil {
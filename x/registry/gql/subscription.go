@@ -0,0 +1,266 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/rpc/core"
+	"github.com/wirelineio/registry/x/registry"
+)
+
+// Actions that change records, tagged along with the IDs of the changed records.
+var recordChangeActions = map[string]bool{
+	registry.ActionSetRecord:      true,
+	registry.ActionRenewRecord:    true,
+	registry.ActionDeleteRecord:   true,
+	registry.ActionOfferTransfer:  true,
+	registry.ActionTransferRecord: true,
+}
+
+// Subscription is the entry point to subscriptions.
+func (r *Resolver) Subscription() SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+type subscriptionResolver struct{ *Resolver }
+
+// Block resolver.
+func (r *Resolver) Block() BlockResolver {
+	return &blockResolver{r}
+}
+
+type blockResolver struct{ *Resolver }
+
+// TxResult resolver.
+func (r *Resolver) TxResult() TxResultResolver {
+	return &txResultResolver{r}
+}
+
+type txResultResolver struct{ *Resolver }
+
+// RecordChange resolver.
+func (r *Resolver) RecordChange() RecordChangeResolver {
+	return &recordChangeResolver{r}
+}
+
+type recordChangeResolver struct{ *Resolver }
+
+func (r *blockResolver) Height(ctx context.Context, obj *Block) (string, error) {
+	val := uint64(obj.Height)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *txResultResolver) Height(ctx context.Context, obj *TxResult) (string, error) {
+	val := uint64(obj.Height)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *recordChangeResolver) Height(ctx context.Context, obj *RecordChange) (string, error) {
+	val := uint64(obj.Height)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *subscriptionResolver) OnRecordChanged(ctx context.Context, typeArg *string, owner *string, attributes []*KeyValueInput,
+	filter *FilterInput) (<-chan *RecordChange, error) {
+	recordFilter, err := getRecordFilter(attributes, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	query := registry.RecordQuery{Filter: recordFilter}
+	if typeArg != nil {
+		query.Type = *typeArg
+	}
+
+	if owner != nil {
+		query.Owner = *owner
+	}
+
+	blocks := r.notifier.subscribe(ctx)
+	changes := make(chan *RecordChange)
+
+	go func() {
+		defer close(changes)
+
+		for block := range blocks {
+			for _, change := range getRecordChanges(block, query) {
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+func (r *subscriptionResolver) OnNewBlock(ctx context.Context) (<-chan *Block, error) {
+	blocks := r.notifier.subscribe(ctx)
+	gqlBlocks := make(chan *Block)
+
+	go func() {
+		defer close(gqlBlocks)
+
+		for block := range blocks {
+			gqlBlock := &Block{
+				Height: BigUInt(block.Height),
+				Hash:   block.Hash,
+				Time:   block.Time.UTC().Format(time.RFC3339),
+				NumTxs: len(block.Txs),
+			}
+
+			select {
+			case gqlBlocks <- gqlBlock:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return gqlBlocks, nil
+}
+
+func (r *subscriptionResolver) OnTxConfirmed(ctx context.Context, hash string) (<-chan *TxResult, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Subscribe before looking for the tx in committed blocks, so that it's either found or notified.
+	blocks := r.notifier.subscribe(ctx)
+	results := make(chan *TxResult, 1)
+
+	// The tx might have been committed before the subscription (e.g. if it was submitted first).
+	if result, found := r.findCommittedTx(hashBytes); found {
+		results <- result
+		close(results)
+
+		return results, nil
+	}
+
+	go func() {
+		defer close(results)
+
+		for block := range blocks {
+			for _, tx := range block.Txs {
+				if !strings.EqualFold(tx.Hash, hash) {
+					continue
+				}
+
+				select {
+				case results <- getGQLTxResult(block.Height, tx):
+				case <-ctx.Done():
+				}
+
+				// The subscription completes once the tx is committed.
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
+
+// findCommittedTx returns the result of a committed tx, using the tx indexer, or the latest committed blocks
+// (as txs are indexed after their block is committed, or if indexing is disabled).
+func (r *Resolver) findCommittedTx(hash []byte) (*TxResult, bool) {
+	if res, err := core.Tx(hash, false); err == nil {
+		return getGQLTxResult(res.Height, CommittedTx{
+			Hash: fmt.Sprintf("%X", hash),
+			Code: res.TxResult.Code,
+			Log:  res.TxResult.Log,
+			Tags: res.TxResult.Tags,
+		}), true
+	}
+
+	if tx, height, found := r.notifier.findRecentTx(fmt.Sprintf("%X", hash)); found {
+		return getGQLTxResult(height, tx), true
+	}
+
+	return nil, false
+}
+
+// getRecordChanges returns the changes to records matching the query, made by the txs (or expiry) in a block.
+// Records are matched using their state at the end of the block (see CaptureRecordChanges).
+func getRecordChanges(block CommittedBlock, query registry.RecordQuery) []*RecordChange {
+	changes := []*RecordChange{}
+
+	for _, committed := range block.RecordChanges {
+		if !query.Matches(committed.Record, committed.Metadata) {
+			continue
+		}
+
+		change := &RecordChange{
+			ID:     string(committed.ID),
+			Action: committed.Action,
+			Height: BigUInt(block.Height),
+			TxHash: committed.TxHash,
+		}
+
+		if committed.Exists {
+			metadata := committed.Metadata
+
+			gqlRecord, err := getGQLRecord(committed.Record, &metadata)
+			if err != nil {
+				continue
+			}
+
+			change.Record = gqlRecord
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+type taggedRecordChange struct {
+	action string
+	id     string
+}
+
+// getTaggedRecordChanges returns the records changed by a tx, from its tags.
+// Each record ID tag belongs to the action tag before it (a tx can have multiple messages).
+func getTaggedRecordChanges(tags []cmn.KVPair) []taggedRecordChange {
+	var changes []taggedRecordChange
+
+	var action string
+	for _, tag := range tags {
+		switch string(tag.Key) {
+		case registry.TagAction:
+			action = string(tag.Value)
+		case registry.TagRecordID:
+			if recordChangeActions[action] {
+				changes = append(changes, taggedRecordChange{action: action, id: string(tag.Value)})
+			}
+		}
+	}
+
+	return changes
+}
+
+func getGQLTxResult(height int64, tx CommittedTx) *TxResult {
+	tags := make([]Tag, len(tx.Tags))
+	for index, tag := range tx.Tags {
+		tags[index] = Tag{Key: string(tag.Key), Value: string(tag.Value)}
+	}
+
+	return &TxResult{
+		Hash:   tx.Hash,
+		Height: BigUInt(height),
+		Code:   int(tx.Code),
+		Log:    tx.Log,
+		Tags:   tags,
+	}
+}
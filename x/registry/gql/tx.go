@@ -0,0 +1,315 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/rpc/core"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/wirelineio/registry/x/registry"
+)
+
+// SimulationResult resolver.
+func (r *Resolver) SimulationResult() SimulationResultResolver {
+	return &simulationResultResolver{r}
+}
+
+type simulationResultResolver struct{ *Resolver }
+
+func (r *simulationResultResolver) GasWanted(ctx context.Context, obj *SimulationResult) (string, error) {
+	val := uint64(obj.GasWanted)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *simulationResultResolver) GasUsed(ctx context.Context, obj *SimulationResult) (string, error) {
+	val := uint64(obj.GasUsed)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *queryResolver) Simulate(ctx context.Context, tx string) (*SimulationResult, error) {
+	stdTx, err := decodeStdTx(r.codec, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := simulateTx(r.Resolver, stdTx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]Tag, len(result.Tags))
+	for index, tag := range result.Tags {
+		tags[index] = Tag{Key: string(tag.Key), Value: string(tag.Value)}
+	}
+
+	simulationResult := SimulationResult{
+		GasWanted: BigUInt(result.GasWanted),
+		GasUsed:   BigUInt(result.GasUsed),
+		Log:       result.Log,
+		Tags:      tags,
+	}
+
+	if !result.IsOK() {
+		txError := newTxError(string(result.Codespace), uint32(result.Code), result.Log)
+		simulationResult.Error = &txError
+	}
+
+	return &simulationResult, nil
+}
+
+// TxError (see schema.graphql) is also the error of a tx rejected by the node (CheckTx) or that failed in a block (DeliverTx).
+// The codespace and code are returned in the GQL error extensions.
+func (err TxError) Error() string {
+	return err.Message
+}
+
+// Extensions implements graphql.ExtendedError.
+func (err TxError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"codespace": err.Codespace,
+		"code":      err.Code,
+	}
+}
+
+// newTxError creates a TxError from the result of a tx, using the message of the SDK error in the log (if any).
+func newTxError(codespace string, code uint32, log string) TxError {
+	return TxError{Codespace: codespace, Code: int(code), Message: registry.GetTxErrorMessage(log)}
+}
+
+// decodeStdTx decodes a base64 encoded, signed tx.
+// Txs are amino JSON encoded auth.StdTx objects (e.g. output by `regcli tx sign`), with or without the type wrapper.
+// They can include multiple msgs of any type registered with the app codec, and multiple signatures.
+// The encoding of older versions of https://github.com/wirelineio/registry-client (untyped registry msgs, with an
+// `operation`) is still supported.
+func decodeStdTx(cdc *codec.Codec, tx string) (*auth.StdTx, error) {
+	bytes, err := base64.StdEncoding.DecodeString(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var objmap map[string]*json.RawMessage
+	err = json.Unmarshal(bytes, &objmap)
+	if err != nil {
+		return nil, err
+	}
+
+	if isLegacyStdTx(objmap) {
+		return decodeLegacyStdTx(objmap)
+	}
+
+	if objmap["type"] == nil {
+		bytes = []byte(fmt.Sprintf(`{"type":"auth/StdTx","value":%s}`, bytes))
+	}
+
+	var stdTx auth.StdTx
+	err = cdc.UnmarshalJSON(bytes, &stdTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stdTx, nil
+}
+
+// isLegacyStdTx checks if a tx uses the legacy registry-client encoding, i.e. an operation or untyped msgs.
+func isLegacyStdTx(objmap map[string]*json.RawMessage) bool {
+	if objmap["operation"] != nil {
+		return true
+	}
+
+	if objmap["msg"] == nil {
+		return false
+	}
+
+	var msgs []map[string]*json.RawMessage
+	if err := json.Unmarshal(*objmap["msg"], &msgs); err != nil || len(msgs) == 0 {
+		return false
+	}
+
+	return msgs[0]["type"] == nil || msgs[0]["value"] == nil
+}
+
+// decodeLegacyStdTx decodes a tx in the legacy registry-client encoding.
+// Note: json.Unmarshal doesn't known which Msg struct to use, so the operation determines the type of the msgs.
+func decodeLegacyStdTx(objmap map[string]*json.RawMessage) (*auth.StdTx, error) {
+	var operationStr = "set"
+	if objmap["operation"] != nil {
+		err := json.Unmarshal(*objmap["operation"], &operationStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if objmap["msg"] == nil || objmap["fee"] == nil || objmap["signatures"] == nil {
+		return nil, errors.New("tx must have msg, fee and signatures")
+	}
+
+	var msgs []sdk.Msg
+
+	switch operationStr {
+	case "set":
+		{
+			var setMsgs []registry.MsgSetRecord
+			err := json.Unmarshal(*objmap["msg"], &setMsgs)
+			if err != nil {
+				return nil, err
+			}
+			for _, msg := range setMsgs {
+				msgs = append(msgs, msg)
+			}
+		}
+	case "delete":
+		{
+			var deleteMsgs []registry.MsgDeleteRecord
+			err := json.Unmarshal(*objmap["msg"], &deleteMsgs)
+			if err != nil {
+				return nil, err
+			}
+			for _, msg := range deleteMsgs {
+				msgs = append(msgs, msg)
+			}
+		}
+	case "transfer":
+		{
+			var transferMsgs []registry.MsgTransferRecord
+			err := json.Unmarshal(*objmap["msg"], &transferMsgs)
+			if err != nil {
+				return nil, err
+			}
+			for _, msg := range transferMsgs {
+				msgs = append(msgs, msg)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown operation: %s", operationStr)
+	}
+
+	var fee auth.StdFee
+	err := json.Unmarshal(*objmap["fee"], &fee)
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs []*json.RawMessage
+	err = json.Unmarshal(*objmap["signatures"], &sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([]auth.StdSignature, len(sigs))
+	for index, sig := range sigs {
+		signatures[index], err = decodeLegacyStdSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var memo string
+	if objmap["memo"] != nil {
+		err = json.Unmarshal(*objmap["memo"], &memo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stdTx := auth.StdTx{
+		Msgs:       msgs,
+		Fee:        fee,
+		Signatures: signatures,
+		Memo:       memo,
+	}
+
+	return &stdTx, nil
+}
+
+// decodeLegacyStdSignature decodes a signature in the legacy registry-client encoding (base64 encoded amino pub key).
+func decodeLegacyStdSignature(bytes *json.RawMessage) (auth.StdSignature, error) {
+	var sig struct {
+		PubKey        string `json:"pub_key"`
+		Signature     []byte `json:"signature"`
+		AccountNumber uint64 `json:"account_number"`
+		Sequence      uint64 `json:"sequence"`
+	}
+
+	err := json.Unmarshal(*bytes, &sig)
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(sig.PubKey)
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+
+	pubKey, err := cryptoAmino.PubKeyFromBytes(pubKeyBytes)
+	if err != nil {
+		return auth.StdSignature{}, err
+	}
+
+	return auth.StdSignature{
+		PubKey:        pubKey,
+		Signature:     sig.Signature,
+		AccountNumber: sig.AccountNumber,
+		Sequence:      sig.Sequence,
+	}, nil
+}
+
+func broadcastTx(r *mutationResolver, stdTx *auth.StdTx) (*ctypes.ResultBroadcastTxCommit, error) {
+	txBytes, err := r.Resolver.codec.MarshalBinaryLengthPrefixed(stdTx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := core.BroadcastTxCommit(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.CheckTx.IsErr() {
+		return nil, newTxError(res.CheckTx.Codespace, res.CheckTx.Code, res.CheckTx.Log)
+	}
+
+	if res.DeliverTx.IsErr() {
+		return nil, newTxError(res.DeliverTx.Codespace, res.DeliverTx.Code, res.DeliverTx.Log)
+	}
+
+	return res, nil
+}
+
+// simulateTx runs a tx in simulate mode (through the ABCI query connection, like `regcli tx ... --dry-run`).
+// State changes are discarded, and nothing is broadcast.
+func simulateTx(r *Resolver, stdTx *auth.StdTx) (sdk.Result, error) {
+	var result sdk.Result
+
+	txBytes, err := r.codec.MarshalBinaryLengthPrefixed(stdTx)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := core.ABCIQuery("/app/simulate", txBytes, 0, false)
+	if err != nil {
+		return result, err
+	}
+
+	if res.Response.IsErr() {
+		return result, newTxError(res.Response.Codespace, res.Response.Code, res.Response.Log)
+	}
+
+	err = r.codec.UnmarshalBinaryLengthPrefixed(res.Response.Value, &result)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,264 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/wirelineio/registry/x/registry"
+	"github.com/wirelineio/registry/x/utxo"
+	utxoutils "github.com/wirelineio/registry/x/utxo/utils"
+)
+
+// UtxoOutPoint resolver.
+func (r *Resolver) UtxoOutPoint() UtxoOutPointResolver {
+	return &utxoOutPointResolver{r}
+}
+
+type utxoOutPointResolver struct{ *Resolver }
+
+// UtxoWallet resolver.
+func (r *Resolver) UtxoWallet() UtxoWalletResolver {
+	return &utxoWalletResolver{r}
+}
+
+type utxoWalletResolver struct{ *Resolver }
+
+// UtxoAccOutput resolver.
+func (r *Resolver) UtxoAccOutput() UtxoAccOutputResolver {
+	return &utxoAccOutputResolver{r}
+}
+
+type utxoAccOutputResolver struct{ *Resolver }
+
+// UtxoTx resolver.
+func (r *Resolver) UtxoTx() UtxoTxResolver {
+	return &utxoTxResolver{r}
+}
+
+type utxoTxResolver struct{ *Resolver }
+
+// UtxoTxIn resolver.
+func (r *Resolver) UtxoTxIn() UtxoTxInResolver {
+	return &utxoTxInResolver{r}
+}
+
+type utxoTxInResolver struct{ *Resolver }
+
+// UtxoTxOut resolver.
+func (r *Resolver) UtxoTxOut() UtxoTxOutResolver {
+	return &utxoTxOutResolver{r}
+}
+
+type utxoTxOutResolver struct{ *Resolver }
+
+func (r *utxoOutPointResolver) Value(ctx context.Context, obj *UtxoOutPoint) (string, error) {
+	val := uint64(obj.Value)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *utxoWalletResolver) Balance(ctx context.Context, obj *UtxoWallet) (string, error) {
+	val := uint64(obj.Balance)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *utxoAccOutputResolver) Value(ctx context.Context, obj *UtxoAccOutput) (string, error) {
+	val := uint64(obj.Value)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *utxoAccOutputResolver) Height(ctx context.Context, obj *UtxoAccOutput) (string, error) {
+	val := uint64(obj.Height)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *utxoTxResolver) LockTime(ctx context.Context, obj *UtxoTx) (string, error) {
+	val := uint64(obj.LockTime)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *utxoTxInResolver) Sequence(ctx context.Context, obj *UtxoTxIn) (string, error) {
+	val := uint64(obj.Sequence)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *utxoTxOutResolver) Value(ctx context.Context, obj *UtxoTxOut) (string, error) {
+	val := uint64(obj.Value)
+	return strconv.FormatUint(val, 10), nil
+}
+
+func (r *queryResolver) GetUtxoWallets(ctx context.Context, addresses []string) ([]*UtxoWallet, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	wallets := make([]*UtxoWallet, len(addresses))
+	for index, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+
+		wallet := r.utxoKeeper.GetWallet(sdkContext, addr)
+
+		outputs := make([]UtxoOutPoint, len(wallet.Entries))
+		for entryIndex, entry := range wallet.Entries {
+			outputs[entryIndex] = UtxoOutPoint{
+				Hash:    entry.Hash.String(),
+				Index:   int(entry.Index),
+				Address: address,
+				Value:   BigUInt(entry.Value),
+			}
+		}
+
+		wallets[index] = &UtxoWallet{
+			Address: address,
+			Balance: BigUInt(wallet.Balance),
+			Outputs: outputs,
+		}
+	}
+
+	return wallets, nil
+}
+
+func (r *queryResolver) GetUtxoTxs(ctx context.Context, hashes []string) ([]*UtxoTx, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	txs := make([]*UtxoTx, len(hashes))
+	for index, hash := range hashes {
+		hashBytes, err := hex.DecodeString(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if !r.utxoKeeper.HasTx(sdkContext, hashBytes) {
+			continue
+		}
+
+		txs[index] = r.getGQLUtxoTx(sdkContext, hashBytes, r.utxoKeeper.GetTx(sdkContext, hashBytes))
+	}
+
+	return txs, nil
+}
+
+func (r *queryResolver) GetUnspentOutputs(ctx context.Context, address *string, first *int, after *string) ([]UtxoOutPoint, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	addr, err := getOptionalAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := registry.DefaultPageSize
+	if first != nil {
+		if *first < 0 || *first > registry.MaxPageSize {
+			return nil, fmt.Errorf("first must be between 0 and %d", registry.MaxPageSize)
+		}
+
+		if *first > 0 {
+			limit = *first
+		}
+	}
+
+	afterKey := ""
+	if after != nil {
+		afterKey = *after
+	}
+
+	outputs := []UtxoOutPoint{}
+	handler := func(outpoint utxo.OutPoint) bool {
+		outputAddress, value, found := r.utxoKeeper.GetOutPointOutput(sdkContext, outpoint)
+		if !found {
+			return false
+		}
+
+		outputs = append(outputs, UtxoOutPoint{
+			Hash:    outpoint.Hash.String(),
+			Index:   int(outpoint.Index),
+			Address: outputAddress.String(),
+			Value:   BigUInt(value),
+		})
+
+		return len(outputs) >= limit
+	}
+
+	if addr != nil {
+		r.utxoKeeper.IterateAddressUtxo(sdkContext, addr, afterKey, handler)
+	} else {
+		r.utxoKeeper.IterateUtxo(sdkContext, afterKey, handler)
+	}
+
+	return outputs, nil
+}
+
+func (r *queryResolver) GetAccOutputs(ctx context.Context, address *string) ([]UtxoAccOutput, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	addr, err := getOptionalAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	accOutputs := []UtxoAccOutput{}
+	for _, accOutput := range r.utxoKeeper.ListAccOutput(sdkContext) {
+		if addr != nil && !accOutput.Address.Equals(addr) {
+			continue
+		}
+
+		outpoint := utxo.OutPoint{Hash: accOutput.ID, Index: utxo.OutPointAccountBirth}
+		accOutputs = append(accOutputs, UtxoAccOutput{
+			ID:      accOutput.ID.String(),
+			Address: accOutput.Address.String(),
+			Value:   BigUInt(accOutput.Value),
+			Height:  BigUInt(accOutput.Block),
+			Spent:   !r.utxoKeeper.HasOutPoint(sdkContext, outpoint),
+		})
+	}
+
+	return accOutputs, nil
+}
+
+func (r *Resolver) getGQLUtxoTx(ctx sdk.Context, hash utxo.Hash, tx utxo.Tx) *UtxoTx {
+	inputs := make([]UtxoTxIn, len(tx.TxIn))
+	for index, txIn := range tx.TxIn {
+		inputs[index] = UtxoTxIn{
+			Hash:     txIn.Input.Hash.String(),
+			Index:    int(txIn.Input.Index),
+			Witness:  utxoutils.BytesToHex(txIn.Witness),
+			Sequence: BigUInt(txIn.Sequence),
+		}
+	}
+
+	outputs := make([]UtxoTxOut, len(tx.TxOut))
+	for index, txOut := range tx.TxOut {
+		outpoint := utxo.OutPoint{Hash: hash, Index: int32(index)}
+		address, _, _ := r.utxoKeeper.GetOutPointOutput(ctx, outpoint)
+
+		outputs[index] = UtxoTxOut{
+			Index:   index,
+			Address: address.String(),
+			Value:   BigUInt(txOut.Value),
+			Spent:   !r.utxoKeeper.HasOutPoint(ctx, outpoint),
+		}
+	}
+
+	return &UtxoTx{
+		Hash:     hash.String(),
+		Inputs:   inputs,
+		Outputs:  outputs,
+		LockTime: BigUInt(tx.LockTime),
+	}
+}
+
+func getOptionalAddress(address *string) (sdk.AccAddress, error) {
+	if address == nil {
+		return nil, nil
+	}
+
+	return sdk.AccAddressFromBech32(*address)
+}
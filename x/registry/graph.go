@@ -17,38 +17,31 @@ func hash(s string) uint32 {
 	return h.Sum32()
 }
 
+// truncate shortens a string to at most length characters (not bytes, so that UTF-8 characters aren't split).
+func truncate(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+
+	return string(runes[:length])
+}
+
 // GraphResourceNode creates a node for a record.
 func GraphResourceNode(g *dot.Graph, r Record) dot.Node {
 	color := fmt.Sprintf("#%x", hash(r.Type)&0x00FFFFFF)
 	node := g.Node(string(r.ID)).Attr("shape", "record").Attr("style", "").Attr("color", color)
 
-	nodeLabel := fmt.Sprintf("%s | %s", string(r.ID)[:18], r.Type)
+	nodeLabel := fmt.Sprintf("%s | %s", truncate(string(r.ID), 18), r.Type)
 	if resourceLabel, ok := r.Attributes["label"].(string); ok {
 		nodeLabel = fmt.Sprintf("%s | %s", nodeLabel, resourceLabel)
 	}
 
 	node.Attr("label", nodeLabel)
 
-	// for _, link := range r.Links {
-	// 	linkID := ""
-	// 	linkLabel := ""
-
-	// 	if idAttr, ok := link["id"].(string); ok {
-	// 		linkID = idAttr
-	// 	}
-
-	// 	if labelAttr, ok := link["label"].(string); ok {
-	// 		linkLabel = labelAttr
-	// 	}
-
-	// 	if linkID != "" {
-	// 		g.Edge(
-	// 			g.Node(string(r.ID)),
-	// 			g.Node(string(linkID)),
-	// 			linkLabel,
-	// 		)
-	// 	}
-	// }
+	for _, link := range r.Links {
+		g.Edge(node, g.Node(string(link.ID)), link.Label)
+	}
 
 	return node
 }
@@ -5,7 +5,10 @@
 package registry
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
@@ -19,8 +22,20 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSetResource(ctx, keeper, msg)
 		case MsgDeleteRecord:
 			return handleMsgDeleteResource(ctx, keeper, msg)
+		case MsgRenewRecord:
+			return handleMsgRenewResource(ctx, keeper, msg)
+		case MsgTransferRecord:
+			return handleMsgTransferResource(ctx, keeper, msg)
 		case MsgClearRecords:
 			return handleMsgClearResources(ctx, keeper, msg)
+		case MsgReserveName:
+			return handleMsgReserveName(ctx, keeper, msg)
+		case MsgSetName:
+			return handleMsgSetName(ctx, keeper, msg)
+		case MsgTransferName:
+			return handleMsgTransferName(ctx, keeper, msg)
+		case MsgReleaseName:
+			return handleMsgReleaseName(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized registry Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -33,19 +48,134 @@ func handleMsgSetResource(ctx sdk.Context, keeper Keeper, msg MsgSetRecord) sdk.
 	payload := PayloadObjToPayload(msg.Payload)
 	record := payload.Record
 
-	if exists := keeper.HasResource(ctx, record.ID); exists {
+	exists := keeper.HasResource(ctx, record.ID)
+
+	if msg.CreateOnly && exists {
+		return ErrRecordExists(record.ID).Result()
+	}
+
+	if msg.ExpectedVersion != 0 {
+		var current uint64
+		if exists {
+			current = keeper.GetResource(ctx, record.ID).Version
+		}
+
+		if current != msg.ExpectedVersion {
+			return ErrVersionConflict(msg.ExpectedVersion, current).Result()
+		}
+	}
+
+	// Versions are tracked across deletes, so that payloads for deleted records can't be replayed either.
+	if expected := keeper.GetLatestVersion(ctx, record.ID) + 1; record.Version != expected {
+		return ErrInvalidVersion(expected, record.Version).Result()
+	}
+
+	if exists {
 		// Check ownership.
-		owner := keeper.GetResource(ctx, record.ID).Owner
+		existing := keeper.GetResource(ctx, record.ID)
 
-		allow := checkAccess(owner, record, payload.Signatures)
+		allow := checkAccess(existing.GetOwners(), existing.GetThreshold(), record, payload.Signatures)
 		if !allow {
 			return sdk.ErrUnauthorized("Unauthorized record write.").Result()
 		}
+
+		// The new owners must consent to a change of ownership.
+		if !sameOwnership(existing, record) {
+			return sdk.ErrUnauthorized("Record owners can only be changed by a transfer.").Result()
+		}
+	} else {
+		// Every declared owner must consent to owning a new record (which also meets the threshold), so that records
+		// can't be created in the name of other accounts, e.g. to use up their records per owner quota.
+		owners := record.GetOwners()
+		allow := checkAccess(owners, len(owners), record, payload.Signatures)
+		if !allow {
+			return sdk.ErrUnauthorized("New records must be signed by all their owners.").Result()
+		}
+	}
+
+	if err := validateRecordType(ctx, keeper, record, exists); err != nil {
+		return err.Result()
+	}
+
+	if err := checkRecordLimits(ctx, keeper, record, exists); err != nil {
+		return err.Result()
+	}
+
+	danglingLinks := false
+	for _, link := range record.Links {
+		if link.ID != record.ID && !keeper.HasResource(ctx, link.ID) {
+			if !msg.AllowDanglingLinks {
+				return ErrLinkNotFound(link.ID).Result()
+			}
+
+			danglingLinks = true
+		}
+	}
+
+	var expiryHeight int64
+	if record.TTL > 0 {
+		height, err := getExpiryHeight(ctx, keeper, ctx.BlockHeight(), record.TTL)
+		if err != nil {
+			return err.Result()
+		}
+
+		// Rent is paid by the (first) owner, who signed the TTL.
+		owner, err := getOwnerAddress(record.Owner)
+		if err != nil {
+			return err.Result()
+		}
+
+		if err := keeper.ChargeRent(ctx, owner, record.TTL); err != nil {
+			return err.Result()
+		}
+
+		expiryHeight = height
+	}
+
+	// The write fee is paid by the tx signer, proportional to the size of the record.
+	if _, err := keeper.ChargeRecordFee(ctx, msg.Signer, record); err != nil {
+		return err.Result()
 	}
 
 	keeper.PutResource(ctx, payload.Record)
 
-	return sdk.Result{}
+	// Records with dangling links are allowed in genesis (see ValidateGenesis) only if they were written that way.
+	if metadata := keeper.GetRecordMetadata(ctx, record.ID); metadata.DanglingLinks != danglingLinks {
+		metadata.DanglingLinks = danglingLinks
+		keeper.putRecordMetadata(ctx, record.ID, metadata)
+	}
+
+	if expiryHeight > 0 {
+		keeper.SetRecordExpiry(ctx, record.ID, expiryHeight)
+	}
+
+	return sdk.Result{Tags: getRecordTags(ActionSetRecord, record, msg.Signer)}
+}
+
+// Handle MsgRenewRecord.
+// Anyone can renew a record, paying the rent from their account.
+func handleMsgRenewResource(ctx sdk.Context, keeper Keeper, msg MsgRenewRecord) sdk.Result {
+	if !keeper.HasResource(ctx, msg.ID) {
+		return sdk.ErrInternal("Record not found.").Result()
+	}
+
+	metadata := keeper.GetRecordMetadata(ctx, msg.ID)
+	if metadata.ExpiryHeight == 0 {
+		return sdk.ErrInternal("Record doesn't expire.").Result()
+	}
+
+	expiryHeight, err := getExpiryHeight(ctx, keeper, metadata.ExpiryHeight, msg.TTL)
+	if err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.ChargeRent(ctx, msg.Signer, msg.TTL); err != nil {
+		return err.Result()
+	}
+
+	keeper.SetRecordExpiry(ctx, msg.ID, expiryHeight)
+
+	return sdk.Result{Tags: getRecordTags(ActionRenewRecord, keeper.GetResource(ctx, msg.ID), msg.Signer)}
 }
 
 // Handle MsgDeleteRecord.
@@ -54,56 +184,384 @@ func handleMsgDeleteResource(ctx sdk.Context, keeper Keeper, msg MsgDeleteRecord
 	record := payload.Record
 
 	if exists := keeper.HasResource(ctx, record.ID); exists {
+		// Deletes must be signed for the current version.
+		if expected := keeper.GetLatestVersion(ctx, record.ID); record.Version != expected {
+			return ErrInvalidVersion(expected, record.Version).Result()
+		}
+
 		// Check ownership.
-		owner := keeper.GetResource(ctx, record.ID).Owner
+		existing := keeper.GetResource(ctx, record.ID)
 
-		allow := checkAccess(owner, record, payload.Signatures)
+		allow := checkAccess(existing.GetOwners(), existing.GetThreshold(), record, payload.Signatures)
 		if !allow {
 			return sdk.ErrUnauthorized("Unauthorized record write.").Result()
 		}
 
+		if existing.Type == TypeDefinitionType && keeper.IsTypeInUse(ctx, string(existing.ID)) {
+			return ErrTypeInUse(string(existing.ID), "type definitions can't be deleted while there are records of the type").Result()
+		}
+
+		if _, err := keeper.RefundRecordFee(ctx, record.ID); err != nil {
+			return err.Result()
+		}
+
 		keeper.DeleteResource(ctx, payload.Record.ID)
 
-		return sdk.Result{}
+		tags := getRecordTags(ActionDeleteRecord, existing, msg.Signer)
+		tags = tags.AppendTags(getDanglingLinkTags(ctx, keeper, existing.ID))
+
+		return sdk.Result{Tags: tags}
 	}
 
 	return sdk.ErrInternal("Record not found.").Result()
 }
 
+// Handle MsgTransferRecord.
+func handleMsgTransferResource(ctx sdk.Context, keeper Keeper, msg MsgTransferRecord) sdk.Result {
+	transfer := msg.Payload.Transfer
+
+	if !keeper.HasResource(ctx, transfer.ID) {
+		return sdk.ErrInternal("Record not found.").Result()
+	}
+
+	// Transfers must be signed for the current version.
+	if expected := keeper.GetLatestVersion(ctx, transfer.ID); transfer.Version != expected {
+		return ErrInvalidVersion(expected, transfer.Version).Result()
+	}
+
+	addresses, ok := getSignerAddresses(GenTransferHash(transfer), msg.Payload.Signatures)
+	if !ok {
+		return sdk.ErrUnauthorized("Invalid transfer signature.").Result()
+	}
+
+	record := keeper.GetResource(ctx, transfer.ID)
+	newOwnership := Record{Owner: transfer.Owner, Owners: transfer.Owners, Threshold: transfer.Threshold}
+
+	if record.Type == TypeDefinitionType {
+		if err := checkTypeDefinitionOwners(ctx, keeper, newOwnership.GetOwners()); err != nil {
+			return err.Result()
+		}
+	}
+
+	// Only owners that don't already own the record get another record.
+	var newOwners []string
+	for _, owner := range newOwnership.GetOwners() {
+		if !containsString(record.GetOwners(), owner) {
+			newOwners = append(newOwners, owner)
+		}
+	}
+
+	offered := hasThreshold(addresses, record.GetOwners(), record.GetThreshold())
+
+	// Every new owner must sign, as well as the threshold of the new ownership, so that current owners who stay on
+	// can't add owners (using up their records per owner quota) without their consent.
+	accepted := hasThreshold(addresses, newOwnership.GetOwners(), newOwnership.GetThreshold()) &&
+		hasThreshold(addresses, newOwners, len(newOwners))
+
+	if !offered {
+		// Accepting a transfer previously offered by the owners.
+		pending := keeper.GetRecordMetadata(ctx, transfer.ID).PendingTransfer
+		offered = pending != nil && bytes.Equal(GenTransferHash(*pending), GenTransferHash(transfer))
+	}
+
+	if !offered {
+		return sdk.ErrUnauthorized("Transfer not signed by the record owners.").Result()
+	}
+
+	if !accepted {
+		keeper.OfferTransfer(ctx, transfer)
+		return sdk.Result{Tags: getTransferTags(ActionOfferTransfer, record, newOwners, msg.Signer)}
+	}
+
+	if err := checkRecordsPerOwner(ctx, keeper, newOwners); err != nil {
+		return err.Result()
+	}
+
+	keeper.TransferResource(ctx, transfer)
+
+	return sdk.Result{Tags: getTransferTags(ActionTransferRecord, record, newOwners, msg.Signer)}
+}
+
 // Handle MsgClearRecords.
 func handleMsgClearResources(ctx sdk.Context, keeper Keeper, msg MsgClearRecords) sdk.Result {
 	keeper.ClearResources(ctx)
 
-	return sdk.Result{}
+	return sdk.Result{Tags: sdk.NewTags(TagAction, []byte(ActionClearRecords), TagSigner, []byte(msg.Signer.String()))}
+}
+
+// Handle MsgReserveName.
+func handleMsgReserveName(ctx sdk.Context, keeper Keeper, msg MsgReserveName) sdk.Result {
+	if keeper.HasName(ctx, msg.Name) {
+		return sdk.ErrInternal("Name already reserved.").Result()
+	}
+
+	keeper.PutName(ctx, msg.Name, NameRecord{Owner: msg.Signer})
+
+	tags := getNameTags(ActionReserveName, msg.Name, msg.Signer).AppendTag(TagNameOwner, []byte(msg.Signer.String()))
+
+	return sdk.Result{Tags: tags}
+}
+
+// Handle MsgSetName.
+func handleMsgSetName(ctx sdk.Context, keeper Keeper, msg MsgSetName) sdk.Result {
+	nameRecord, err := getOwnedName(ctx, keeper, msg.Name, msg.Signer)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !keeper.HasResource(ctx, msg.ID) {
+		return sdk.ErrInternal("Record not found.").Result()
+	}
+
+	nameRecord.ID = msg.ID
+	keeper.PutName(ctx, msg.Name, nameRecord)
+
+	tags := getNameTags(ActionSetName, msg.Name, msg.Signer).AppendTag(TagRecordID, []byte(msg.ID))
+
+	return sdk.Result{Tags: tags}
+}
+
+// Handle MsgTransferName.
+func handleMsgTransferName(ctx sdk.Context, keeper Keeper, msg MsgTransferName) sdk.Result {
+	nameRecord, err := getOwnedName(ctx, keeper, msg.Name, msg.Signer)
+	if err != nil {
+		return err.Result()
+	}
+
+	nameRecord.Owner = msg.NewOwner
+	keeper.PutName(ctx, msg.Name, nameRecord)
+
+	tags := getNameTags(ActionTransferName, msg.Name, msg.Signer).AppendTag(TagNameOwner, []byte(msg.NewOwner.String()))
+
+	return sdk.Result{Tags: tags}
+}
+
+// Handle MsgReleaseName.
+func handleMsgReleaseName(ctx sdk.Context, keeper Keeper, msg MsgReleaseName) sdk.Result {
+	if _, err := getOwnedName(ctx, keeper, msg.Name, msg.Signer); err != nil {
+		return err.Result()
+	}
+
+	keeper.DeleteName(ctx, msg.Name)
+
+	return sdk.Result{Tags: getNameTags(ActionReleaseName, msg.Name, msg.Signer)}
+}
+
+// getOwnedName gets a name, checking that it's owned by the signer.
+func getOwnedName(ctx sdk.Context, keeper Keeper, name string, signer sdk.AccAddress) (NameRecord, sdk.Error) {
+	if !keeper.HasName(ctx, name) {
+		return NameRecord{}, sdk.ErrInternal("Name not found.")
+	}
+
+	nameRecord := keeper.GetName(ctx, name)
+	if !nameRecord.Owner.Equals(signer) {
+		return NameRecord{}, sdk.ErrUnauthorized("Unauthorized name write.")
+	}
+
+	return nameRecord, nil
+}
+
+// getExpiryHeight returns the height ttl blocks after the given height, checking that it's at most max_ttl blocks
+// after the current block (which also keeps the addition from overflowing).
+func getExpiryHeight(ctx sdk.Context, keeper Keeper, height int64, ttl int64) (int64, sdk.Error) {
+	maxTTL := keeper.GetParams(ctx).MaxTTL
+	if ttl > maxTTL || height > math.MaxInt64-ttl || height+ttl-ctx.BlockHeight() > maxTTL {
+		return 0, ErrLimitExceeded(fmt.Sprintf("Record can't be kept for more than the max TTL of %d blocks.", maxTTL))
+	}
+
+	return height + ttl, nil
+}
+
+// getOwnerAddress returns the account of a record owner (owners are hex encoded addresses).
+func getOwnerAddress(owner string) (sdk.AccAddress, sdk.Error) {
+	address, err := hex.DecodeString(owner)
+	if err != nil || len(address) == 0 {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("Invalid record owner %s.", owner))
+	}
+
+	return sdk.AccAddress(address), nil
+}
+
+// validateRecordType checks record attributes against the schema registered for the record type, if any.
+func validateRecordType(ctx sdk.Context, keeper Keeper, record Record, exists bool) sdk.Error {
+	if record.Type == TypeDefinitionType {
+		return checkTypeDefinitionChange(ctx, keeper, record, exists)
+	}
+
+	schema, exists := keeper.GetSchema(ctx, record.Type)
+	if !exists {
+		return nil
+	}
+
+	if err := schema.Validate(record.Attributes); err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Invalid attributes for type %s: %s.", record.Type, err))
+	}
+
+	return nil
+}
+
+// checkTypeDefinitionChange checks that a type definition is owned by admins, and that the new schema of a type
+// that's in use is compatible with the previous one (or, for a new type definition, matches the existing records),
+// so that the existing records of the type stay valid.
+// The schema itself is checked in ValidateBasic.
+func checkTypeDefinitionChange(ctx sdk.Context, keeper Keeper, record Record, exists bool) sdk.Error {
+	if err := checkTypeDefinitionOwners(ctx, keeper, record.GetOwners()); err != nil {
+		return err
+	}
+
+	recordType := string(record.ID)
+	if !keeper.IsTypeInUse(ctx, recordType) {
+		return nil
+	}
+
+	schema, err := ParseSchema(record.Attributes)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Invalid type definition: %s.", err))
+	}
+
+	if !exists {
+		for _, id := range keeper.GetIDsByType(ctx, recordType) {
+			if err := schema.Validate(keeper.GetResource(ctx, id).Attributes); err != nil {
+				return ErrTypeInUse(recordType, fmt.Sprintf("record %s doesn't match the schema: %s", id, err))
+			}
+		}
+
+		return nil
+	}
+
+	previous, _ := keeper.GetSchema(ctx, recordType)
+
+	if err := schema.CheckCompatible(previous); err != nil {
+		return ErrTypeInUse(recordType, err.Error())
+	}
+
+	return nil
+}
+
+// checkTypeDefinitionOwners checks that type definitions are only owned by admins, unless the chain is in dev mode.
+func checkTypeDefinitionOwners(ctx sdk.Context, keeper Keeper, owners []string) sdk.Error {
+	config := keeper.adminKeeper.GetConfig(ctx)
+	if config.DevMode {
+		return nil
+	}
+
+	for _, owner := range owners {
+		address, err := getOwnerAddress(owner)
+		if err != nil {
+			return err
+		}
+
+		if !config.IsAdmin(address) {
+			return sdk.ErrUnauthorized("Type definitions can only be owned by admins.")
+		}
+	}
+
+	return nil
+}
+
+// checkRecordLimits checks the record against the registry params (allowed types, attribute size, records per owner).
+func checkRecordLimits(ctx sdk.Context, keeper Keeper, record Record, exists bool) sdk.Error {
+	params := keeper.GetParams(ctx)
+
+	if !params.IsTypeAllowed(record.Type) {
+		return ErrTypeNotAllowed(record.Type)
+	}
+
+	if params.MaxAttributeSize > 0 {
+		if size := int64(len(MarshalMapToJSONBytes(record.Attributes))); size > params.MaxAttributeSize {
+			return ErrLimitExceeded(fmt.Sprintf("Record attributes size %d exceeds the max of %d bytes.", size, params.MaxAttributeSize))
+		}
+	}
+
+	// Updates don't change the owners (see sameOwnership).
+	if !exists {
+		return checkRecordsPerOwner(ctx, keeper, record.GetOwners())
+	}
+
+	return nil
 }
 
-func checkAccess(owner string, record Record, signatures []Signature) bool {
+// checkRecordsPerOwner checks that the owners can own another record.
+func checkRecordsPerOwner(ctx sdk.Context, keeper Keeper, owners []string) sdk.Error {
+	maxRecords := keeper.GetParams(ctx).MaxRecordsPerOwner
+	if maxRecords == 0 {
+		return nil
+	}
+
+	for _, owner := range owners {
+		if int64(len(keeper.GetIDsByOwner(ctx, owner))) >= maxRecords {
+			return ErrLimitExceeded(fmt.Sprintf("Owner %s already has the max of %d records.", owner, maxRecords))
+		}
+	}
+
+	return nil
+}
+
+// sameOwnership checks if two versions of a record have the same owners and threshold.
+func sameOwnership(a Record, b Record) bool {
+	if a.GetThreshold() != b.GetThreshold() {
+		return false
+	}
+
+	aOwners, bOwners := a.GetOwners(), b.GetOwners()
+	if len(aOwners) != len(bOwners) {
+		return false
+	}
+
+	owners := make(map[string]bool)
+	for _, owner := range aOwners {
+		owners[owner] = true
+	}
+
+	for _, owner := range bOwners {
+		if !owners[owner] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkAccess checks that the record is signed by at least threshold of the owners.
+func checkAccess(owners []string, threshold int, record Record, signatures []Signature) bool {
+	addresses, ok := getSignerAddresses(GenRecordHash(record), signatures)
+	if !ok {
+		return false
+	}
+
+	return hasThreshold(addresses, owners, threshold)
+}
+
+// getSignerAddresses verifies the signatures, returning the addresses of the signers.
+func getSignerAddresses(signBytes []byte, signatures []Signature) (map[string]bool, bool) {
 	addresses := make(map[string]bool)
 
 	// Check signatures.
-	resourceSignBytes := GenRecordHash(record)
 	for _, sig := range signatures {
 		pubKey, err := cryptoAmino.PubKeyFromBytes(BytesFromBase64(sig.PubKey))
 		if err != nil {
-			fmt.Println("Error decoding pubKey from bytes.")
-			return false
+			return nil, false
 		}
 
 		addresses[GetAddressFromPubKey(pubKey)] = true
 
-		allow := pubKey.VerifyBytes(resourceSignBytes, BytesFromBase64(sig.Signature))
+		allow := pubKey.VerifyBytes(signBytes, BytesFromBase64(sig.Signature))
 		if !allow {
-			fmt.Println("Signature mismatch: ", sig.PubKey)
-
-			return false
+			return nil, false
 		}
 	}
 
-	// Check one of the addresses matches the owner.
-	_, ok := addresses[owner]
-	if !ok {
-		return false
+	return addresses, true
+}
+
+// hasThreshold checks that at least threshold of the owners are among the signer addresses.
+func hasThreshold(addresses map[string]bool, owners []string, threshold int) bool {
+	signed := 0
+	for _, owner := range owners {
+		if addresses[owner] {
+			signed++
+		}
 	}
 
-	return true
+	return signed >= threshold
 }
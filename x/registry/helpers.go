@@ -9,20 +9,34 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"strings"
 
 	"github.com/tendermint/tendermint/crypto"
 	"golang.org/x/crypto/ripemd160"
 )
 
-// GenRecordHash generates a transaction hash.
+// GenRecordHash generates a record hash, for signing (see SignFormatVersion).
 func GenRecordHash(r Record) []byte {
-	first := sha256.New()
-
-	bytes, err := json.MarshalIndent(r, "", "  ")
+	bytes, err := GetRecordSignBytes(r)
 	if err != nil {
 		panic("Record marshal error.")
 	}
 
+	return genHash(bytes)
+}
+
+// GenTransferHash generates a hash of a record transfer, for signing (see SignFormatVersion).
+func GenTransferHash(t Transfer) []byte {
+	bytes, err := GetTransferSignBytes(t)
+	if err != nil {
+		panic("Transfer marshal error.")
+	}
+
+	return genHash(bytes)
+}
+
+func genHash(bytes []byte) []byte {
+	first := sha256.New()
 	first.Write(bytes)
 	firstHash := first.Sum(nil)
 
@@ -75,3 +89,29 @@ func BytesFromHex(str string) []byte {
 
 	return bytes
 }
+
+// GetTxErrorMessage gets the error message from the log of a failed tx (or the log itself, if it has no SDK error).
+func GetTxErrorMessage(log string) string {
+	// Failed msg logs are formatted as `Msg <index> failed: <ABCI log>`, and ABCI logs are JSON encoded SDK errors.
+	if index := strings.Index(log, "{"); index >= 0 {
+		var abciLog struct {
+			Message string `json:"message"`
+		}
+
+		if err := json.Unmarshal([]byte(log[index:]), &abciLog); err == nil && abciLog.Message != "" {
+			return abciLog.Message
+		}
+	}
+
+	return log
+}
+
+func containsString(list []string, str string) bool {
+	for _, item := range list {
+		if item == str {
+			return true
+		}
+	}
+
+	return false
+}
@@ -5,34 +5,102 @@
 package registry
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/golang-collections/collections/stack"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/wirelineio/registry/x/admin"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine.
 type Keeper struct {
-	accountKeeper    auth.AccountKeeper
-	coinKeeper       bank.Keeper
-	resourceStoreKey sdk.StoreKey // Unexposed key to access record store from sdk.Context.
-	cdc              *codec.Codec // The wire codec for binary encoding/decoding.
+	accountKeeper       auth.AccountKeeper
+	adminKeeper         admin.Keeper
+	coinKeeper          bank.Keeper
+	feeCollectionKeeper auth.FeeCollectionKeeper
+	resourceStoreKey    sdk.StoreKey // Unexposed key to access record store from sdk.Context.
+	revisionStoreKey    sdk.StoreKey // Unexposed key to access record revision store from sdk.Context.
+	indexStoreKey       sdk.StoreKey // Unexposed key to access record index store from sdk.Context.
+	nameStoreKey        sdk.StoreKey // Unexposed key to access name store from sdk.Context.
+	metadataStoreKey    sdk.StoreKey // Unexposed key to access record metadata store from sdk.Context.
+	paramstore          params.Subspace
+	cdc                 *codec.Codec // The wire codec for binary encoding/decoding.
 }
 
 // NewKeeper creates new instances of the UTXO Keeper.
-func NewKeeper(accountKeeper auth.AccountKeeper, coinKeeper bank.Keeper, resourceStoreKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+func NewKeeper(accountKeeper auth.AccountKeeper, adminKeeper admin.Keeper, coinKeeper bank.Keeper, feeCollectionKeeper auth.FeeCollectionKeeper, resourceStoreKey sdk.StoreKey, revisionStoreKey sdk.StoreKey, indexStoreKey sdk.StoreKey, nameStoreKey sdk.StoreKey, metadataStoreKey sdk.StoreKey, paramstore params.Subspace, cdc *codec.Codec) Keeper {
 	return Keeper{
-		accountKeeper:    accountKeeper,
-		coinKeeper:       coinKeeper,
-		resourceStoreKey: resourceStoreKey,
-		cdc:              cdc,
+		accountKeeper:       accountKeeper,
+		adminKeeper:         adminKeeper,
+		coinKeeper:          coinKeeper,
+		feeCollectionKeeper: feeCollectionKeeper,
+		resourceStoreKey:    resourceStoreKey,
+		revisionStoreKey:    revisionStoreKey,
+		indexStoreKey:       indexStoreKey,
+		nameStoreKey:        nameStoreKey,
+		metadataStoreKey:    metadataStoreKey,
+		paramstore:          paramstore.WithTypeTable(ParamTypeTable()),
+		cdc:                 cdc,
 	}
 }
 
-// PutResource - saves a record to the store.
+// PutResource - saves a record to the store, along with a new immutable revision, incrementing the record version.
+// Also updates the record metadata (create/update height, time and tx hash).
 func (k Keeper) PutResource(ctx sdk.Context, record Record) {
+	// Record versions and revision numbers are the same.
+	record.Version = k.GetLatestVersion(ctx, record.ID) + 1
+	recordObj := RecordToRecordObj(record)
+
+	metadata := k.GetRecordMetadata(ctx, record.ID)
+
+	if k.HasResource(ctx, record.ID) {
+		k.removeIndexes(ctx, k.GetResource(ctx, record.ID), metadata)
+	} else {
+		metadata.CreateHeight = ctx.BlockHeight()
+		metadata.CreateTime = ctx.BlockHeader().Time
+		metadata.CreateTxHash = getTxHash(ctx)
+	}
+
+	metadata.UpdateHeight = ctx.BlockHeight()
+	metadata.UpdateTime = ctx.BlockHeader().Time
+	metadata.TxHash = getTxHash(ctx)
+	k.putRecordMetadata(ctx, record.ID, metadata)
+
+	k.addIndexes(ctx, record, metadata)
+
+	store := ctx.KVStore(k.resourceStoreKey)
+	store.Set([]byte(record.ID), k.cdc.MustMarshalBinaryBare(recordObj))
+
+	k.putRevision(ctx, RevisionObj{
+		Version: record.Version,
+		Height:  ctx.BlockHeight(),
+		TxHash:  getTxHash(ctx),
+		Record:  recordObj,
+	})
+}
+
+// ImportResource - saves a record and its metadata as is (e.g. from genesis), updating the indexes.
+// Unlike PutResource, the record version isn't changed and no revision is added.
+func (k Keeper) ImportResource(ctx sdk.Context, record Record, metadata RecordMetadata) {
+	if k.HasResource(ctx, record.ID) {
+		k.removeIndexes(ctx, k.GetResource(ctx, record.ID), k.GetRecordMetadata(ctx, record.ID))
+	}
+
+	k.addIndexes(ctx, record, metadata)
+
 	store := ctx.KVStore(k.resourceStoreKey)
 	store.Set([]byte(record.ID), k.cdc.MustMarshalBinaryBare(RecordToRecordObj(record)))
+
+	expiryHeight := metadata.ExpiryHeight
+	metadata.ExpiryHeight = k.GetRecordMetadata(ctx, record.ID).ExpiryHeight
+	k.putRecordMetadata(ctx, record.ID, metadata)
+	k.SetRecordExpiry(ctx, record.ID, expiryHeight)
 }
 
 // HasResource - checks if a record by the given ID exists.
@@ -71,19 +139,830 @@ func (k Keeper) ListResources(ctx sdk.Context) []Record {
 	return records
 }
 
+type graphEntry struct {
+	id    ID
+	depth int
+}
+
+// GetRecordGraph - gets the records reachable from a record by following links, up to the given depth
+// (the record itself is at depth 0). A depth <= 0 means no limit. Links to missing records are skipped.
+func (k Keeper) GetRecordGraph(ctx sdk.Context, id ID, depth int) []Record {
+	var records []Record
+
+	// Lowest depth each record was reached at, so that records reached again by a shorter path are expanded again.
+	visited := make(map[ID]int)
+
+	pending := stack.New()
+	pending.Push(graphEntry{id: id, depth: 0})
+
+	for pending.Len() > 0 {
+		entry := pending.Pop().(graphEntry)
+
+		if visitedDepth, ok := visited[entry.id]; ok && visitedDepth <= entry.depth {
+			continue
+		}
+
+		if !k.HasResource(ctx, entry.id) {
+			continue
+		}
+
+		record := k.GetResource(ctx, entry.id)
+		if _, ok := visited[entry.id]; !ok {
+			records = append(records, record)
+		}
+
+		visited[entry.id] = entry.depth
+
+		if depth > 0 && entry.depth >= depth {
+			continue
+		}
+
+		for _, link := range record.Links {
+			pending.Push(graphEntry{id: link.ID, depth: entry.depth + 1})
+		}
+	}
+
+	return records
+}
+
+// GetSchema - gets the schema registered for a record type, if a type definition record exists for it.
+func (k Keeper) GetSchema(ctx sdk.Context, recordType string) (Schema, bool) {
+	id := ID(recordType)
+	if !k.HasResource(ctx, id) {
+		return Schema{}, false
+	}
+
+	typeDef := k.GetResource(ctx, id)
+	if typeDef.Type != TypeDefinitionType {
+		return Schema{}, false
+	}
+
+	// Type definitions are validated before being saved.
+	schema, err := ParseSchema(typeDef.Attributes)
+	if err != nil {
+		return Schema{}, false
+	}
+
+	return schema, true
+}
+
 // DeleteResource - deletes a record from the store.
+// Any fee still held in escrow against the record (i.e. not refunded) is added to the collected fees.
 func (k Keeper) DeleteResource(ctx sdk.Context, id ID) {
+	metadata := k.GetRecordMetadata(ctx, id)
+	if k.HasResource(ctx, id) {
+		k.removeIndexes(ctx, k.GetResource(ctx, id), metadata)
+	}
+
+	if metadata.ExpiryHeight > 0 {
+		ctx.KVStore(k.indexStoreKey).Delete(getExpiryQueueKey(metadata.ExpiryHeight, id))
+	}
+
+	k.releaseFeeEscrow(ctx, metadata)
+
+	ctx.KVStore(k.metadataStoreKey).Delete([]byte(id))
+
 	store := ctx.KVStore(k.resourceStoreKey)
 	store.Delete([]byte(id))
 }
 
-// ClearResources - Deletes all records.
+// ClearResources - Deletes all records, including their revision history and indexes, and all names.
+// Fees held in escrow are added to the collected fees.
 // NOTE: FOR LOCAL TESTING PURPOSES ONLY!
 func (k Keeper) ClearResources(ctx sdk.Context) {
-	store := ctx.KVStore(k.resourceStoreKey)
+	for _, record := range k.ListResources(ctx) {
+		k.releaseFeeEscrow(ctx, k.GetRecordMetadata(ctx, record.ID))
+	}
+
+	clearStore(ctx.KVStore(k.resourceStoreKey))
+	clearStore(ctx.KVStore(k.revisionStoreKey))
+	clearStore(ctx.KVStore(k.indexStoreKey))
+	clearStore(ctx.KVStore(k.nameStoreKey))
+	clearStore(ctx.KVStore(k.metadataStoreKey))
+}
+
+func clearStore(store sdk.KVStore) {
 	itr := store.Iterator(nil, nil)
 	defer itr.Close()
 	for ; itr.Valid(); itr.Next() {
 		store.Delete(itr.Key())
 	}
 }
+
+// GetRecordMetadata - gets the metadata of a record.
+func (k Keeper) GetRecordMetadata(ctx sdk.Context, id ID) RecordMetadata {
+	var metadata RecordMetadata
+
+	store := ctx.KVStore(k.metadataStoreKey)
+	bz := store.Get([]byte(id))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+	}
+
+	return metadata
+}
+
+func (k Keeper) putRecordMetadata(ctx sdk.Context, id ID, metadata RecordMetadata) {
+	store := ctx.KVStore(k.metadataStoreKey)
+	store.Set([]byte(id), k.cdc.MustMarshalBinaryBare(metadata))
+}
+
+func getExpiryQueueKey(height int64, id ID) []byte {
+	return append(getExpiryQueuePrefix(height), []byte(id)...)
+}
+
+func getExpiryQueuePrefix(height int64) []byte {
+	return append(append([]byte{}, expiryQueuePrefix...), []byte(fmt.Sprintf("%020d\x00", height))...)
+}
+
+// SetRecordExpiry - sets the block height after which a record is pruned (0 for never).
+func (k Keeper) SetRecordExpiry(ctx sdk.Context, id ID, expiryHeight int64) {
+	store := ctx.KVStore(k.indexStoreKey)
+
+	metadata := k.GetRecordMetadata(ctx, id)
+	if metadata.ExpiryHeight > 0 {
+		store.Delete(getExpiryQueueKey(metadata.ExpiryHeight, id))
+	}
+
+	if expiryHeight > 0 {
+		store.Set(getExpiryQueueKey(expiryHeight, id), []byte{})
+	}
+
+	metadata.ExpiryHeight = expiryHeight
+	k.putRecordMetadata(ctx, id, metadata)
+}
+
+// GetExpiredRecords - gets the IDs of records that expire at or before the given block height.
+func (k Keeper) GetExpiredRecords(ctx sdk.Context, height int64) []ID {
+	var ids []ID
+
+	store := ctx.KVStore(k.indexStoreKey)
+	itr := store.Iterator(expiryQueuePrefix, getExpiryQueuePrefix(height+1))
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		// Strip the prefix and zero padded height.
+		ids = append(ids, ID(itr.Key()[len(getExpiryQueuePrefix(0)):]))
+	}
+
+	return ids
+}
+
+// OfferTransfer - saves a transfer offered by the record owners, until it's accepted by the new owners.
+func (k Keeper) OfferTransfer(ctx sdk.Context, transfer Transfer) {
+	metadata := k.GetRecordMetadata(ctx, transfer.ID)
+	metadata.PendingTransfer = &transfer
+	k.putRecordMetadata(ctx, transfer.ID, metadata)
+}
+
+// TransferResource - changes the owners of a record, recording the transfer in the record metadata.
+func (k Keeper) TransferResource(ctx sdk.Context, transfer Transfer) {
+	record := k.GetResource(ctx, transfer.ID)
+	from := record.GetOwners()
+
+	record.Owner = transfer.Owner
+	record.Owners = transfer.Owners
+	record.Threshold = transfer.Threshold
+	k.PutResource(ctx, record)
+
+	metadata := k.GetRecordMetadata(ctx, transfer.ID)
+	metadata.PendingTransfer = nil
+	metadata.Transfers = append(metadata.Transfers, OwnershipTransfer{
+		Height: ctx.BlockHeight(),
+		TxHash: getTxHash(ctx),
+		From:   from,
+		To:     record.GetOwners(),
+	})
+	k.putRecordMetadata(ctx, transfer.ID, metadata)
+}
+
+// ChargeRent - deducts the rent for keeping a record for the given number of blocks from the payer's account,
+// and adds it to the collected fees.
+func (k Keeper) ChargeRent(ctx sdk.Context, payer sdk.AccAddress, blocks int64) sdk.Error {
+	rentPerBlock := k.GetParams(ctx).RentPerBlock
+	rent := sdk.NewCoin(rentPerBlock.Denom, rentPerBlock.Amount.MulRaw(blocks))
+	if rent.IsZero() {
+		return nil
+	}
+
+	_, _, err := k.coinKeeper.SubtractCoins(ctx, payer, sdk.Coins{rent})
+	if err != nil {
+		return sdk.ErrInsufficientCoins("Not enough coins to pay record rent.")
+	}
+
+	k.feeCollectionKeeper.AddCollectedFees(ctx, sdk.Coins{rent})
+
+	return nil
+}
+
+// GetRecordFee - gets the fee for writing a record, proportional to the size of the serialized record.
+func (k Keeper) GetRecordFee(ctx sdk.Context, record Record) sdk.Coins {
+	size := len(k.cdc.MustMarshalBinaryBare(RecordToRecordObj(record)))
+
+	feePerByte := k.GetParams(ctx).FeePerByte
+	fee := sdk.NewCoin(feePerByte.Denom, feePerByte.Amount.MulRaw(int64(size)))
+	if fee.IsZero() {
+		return nil
+	}
+
+	return sdk.Coins{fee}
+}
+
+// ChargeRecordFee - deducts the fee for writing a record from the payer's account. The refundable part of the fee
+// (the fee_refund_rate param) is held in escrow against the record, to be refunded on delete, and the rest is added to
+// the collected fees. The fee, escrow and payer are saved in the record metadata, and the escrow of the previous write
+// (which is no longer refundable) is added to the collected fees.
+func (k Keeper) ChargeRecordFee(ctx sdk.Context, payer sdk.AccAddress, record Record) (sdk.Coins, sdk.Error) {
+	fee := k.GetRecordFee(ctx, record)
+	if !fee.IsZero() {
+		_, _, err := k.coinKeeper.SubtractCoins(ctx, payer, fee)
+		if err != nil {
+			return nil, sdk.ErrInsufficientCoins(fmt.Sprintf("Not enough coins to pay record fee %s.", fee))
+		}
+	}
+
+	refundRate := k.GetParams(ctx).FeeRefundRate
+
+	escrow := sdk.Coins{}
+	for _, coin := range fee {
+		escrow = escrow.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, refundRate.MulInt(coin.Amount).TruncateInt())})
+	}
+
+	metadata := k.GetRecordMetadata(ctx, record.ID)
+	k.releaseFeeEscrow(ctx, metadata)
+
+	if collected := fee.Minus(escrow); !collected.IsZero() {
+		k.feeCollectionKeeper.AddCollectedFees(ctx, collected)
+	}
+
+	metadata.Fee = fee
+	metadata.FeeEscrow = escrow
+	metadata.FeePayer = payer
+	k.putRecordMetadata(ctx, record.ID, metadata)
+
+	return fee, nil
+}
+
+// RefundRecordFee - refunds the part of the fee paid for the latest write of a record held in escrow to the account
+// that paid it.
+func (k Keeper) RefundRecordFee(ctx sdk.Context, id ID) (sdk.Coins, sdk.Error) {
+	metadata := k.GetRecordMetadata(ctx, id)
+	refund := metadata.FeeEscrow
+	if refund.IsZero() || metadata.FeePayer.Empty() {
+		return nil, nil
+	}
+
+	_, _, err := k.coinKeeper.AddCoins(ctx, metadata.FeePayer, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.FeeEscrow = nil
+	k.putRecordMetadata(ctx, id, metadata)
+
+	return refund, nil
+}
+
+// releaseFeeEscrow adds the fee held in escrow against a record (if any) to the collected fees, e.g. when the record
+// is overwritten or expires.
+func (k Keeper) releaseFeeEscrow(ctx sdk.Context, metadata RecordMetadata) {
+	if !metadata.FeeEscrow.IsZero() {
+		k.feeCollectionKeeper.AddCollectedFees(ctx, metadata.FeeEscrow)
+	}
+}
+
+// PutName - saves a name to the store.
+func (k Keeper) PutName(ctx sdk.Context, name string, nameRecord NameRecord) {
+	store := ctx.KVStore(k.nameStoreKey)
+	store.Set([]byte(name), k.cdc.MustMarshalBinaryBare(nameRecord))
+}
+
+// HasName - checks if a name has been reserved.
+func (k Keeper) HasName(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.nameStoreKey)
+	return store.Has([]byte(name))
+}
+
+// GetName - gets a name from the store.
+func (k Keeper) GetName(ctx sdk.Context, name string) NameRecord {
+	store := ctx.KVStore(k.nameStoreKey)
+
+	bz := store.Get([]byte(name))
+	var obj NameRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+
+	return obj
+}
+
+// DeleteName - deletes a name from the store.
+func (k Keeper) DeleteName(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.nameStoreKey)
+	store.Delete([]byte(name))
+}
+
+// ResolveName - gets the record a name points at, if any.
+func (k Keeper) ResolveName(ctx sdk.Context, name string) (Record, bool) {
+	if !k.HasName(ctx, name) {
+		return Record{}, false
+	}
+
+	id := k.GetName(ctx, name).ID
+	if id == "" || !k.HasResource(ctx, id) {
+		return Record{}, false
+	}
+
+	return k.GetResource(ctx, id), true
+}
+
+// IterateNames - iterates over all names, in name order, until the handler returns true.
+func (k Keeper) IterateNames(ctx sdk.Context, handler func(name string, nameRecord NameRecord) (stop bool)) {
+	store := ctx.KVStore(k.nameStoreKey)
+	itr := store.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var nameRecord NameRecord
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &nameRecord)
+
+		if handler(string(itr.Key()), nameRecord) {
+			break
+		}
+	}
+}
+
+// GetRevisionKey returns the key used in the revision store for the given record version.
+// Versions are zero padded so that revisions of a record are iterated in order.
+func GetRevisionKey(id ID, version uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%020d", id, VersionSeparator, version))
+}
+
+func getRevisionPrefix(id ID) []byte {
+	return []byte(string(id) + VersionSeparator)
+}
+
+func (k Keeper) putRevision(ctx sdk.Context, revision RevisionObj) {
+	store := ctx.KVStore(k.revisionStoreKey)
+	store.Set(GetRevisionKey(revision.Record.ID, revision.Version), k.cdc.MustMarshalBinaryBare(revision))
+}
+
+// IterateRevisions - iterates over the revisions of all records (including deleted records),
+// in ID and version order, until the handler returns true.
+func (k Keeper) IterateRevisions(ctx sdk.Context, handler func(revision RevisionObj) (stop bool)) {
+	store := ctx.KVStore(k.revisionStoreKey)
+	itr := store.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var revision RevisionObj
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &revision)
+
+		if handler(revision) {
+			break
+		}
+	}
+}
+
+// HasRevision - checks if the given revision of a record exists.
+func (k Keeper) HasRevision(ctx sdk.Context, id ID, version uint64) bool {
+	store := ctx.KVStore(k.revisionStoreKey)
+	return store.Has(GetRevisionKey(id, version))
+}
+
+// GetRevision - gets a record revision from the store.
+func (k Keeper) GetRevision(ctx sdk.Context, id ID, version uint64) Revision {
+	store := ctx.KVStore(k.revisionStoreKey)
+
+	bz := store.Get(GetRevisionKey(id, version))
+	var obj RevisionObj
+	k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+
+	return RevisionObjToRevision(obj)
+}
+
+// ListRevisions - gets the revision history of a record, oldest first.
+// History is retained after a record is deleted.
+func (k Keeper) ListRevisions(ctx sdk.Context, id ID) []Revision {
+	var revisions []Revision
+
+	store := ctx.KVStore(k.revisionStoreKey)
+	itr := sdk.KVStorePrefixIterator(store, getRevisionPrefix(id))
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var obj RevisionObj
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &obj)
+		revisions = append(revisions, RevisionObjToRevision(obj))
+	}
+
+	return revisions
+}
+
+// GetLatestVersion - gets the version number of the latest revision of a record (0 if it was never written).
+func (k Keeper) GetLatestVersion(ctx sdk.Context, id ID) uint64 {
+	store := ctx.KVStore(k.revisionStoreKey)
+	itr := sdk.KVStoreReversePrefixIterator(store, getRevisionPrefix(id))
+	defer itr.Close()
+	if !itr.Valid() {
+		return 0
+	}
+
+	var obj RevisionObj
+	k.cdc.MustUnmarshalBinaryBare(itr.Value(), &obj)
+
+	return obj.Version
+}
+
+// Index keys are of the form <prefix><value>\x00<id>, so that the IDs for a value can be found by prefix iteration,
+// and records can be listed in value order.
+var (
+	typeIndexPrefix         = []byte("type\x00")
+	ownerIndexPrefix        = []byte("owner\x00")
+	attrIndexPrefix         = []byte("attr\x00")
+	updateHeightIndexPrefix = []byte("update\x00")
+	linkIndexPrefix         = []byte("link\x00") // Indexed by link target.
+
+	// Expiry queue keys are of the form <prefix><zero padded height>\x00<id>, so that they are iterated by height.
+	expiryQueuePrefix = []byte("expiry\x00")
+)
+
+func getIndexPrefix(prefix []byte, value string) []byte {
+	return append(append([]byte{}, prefix...), []byte(value+"\x00")...)
+}
+
+// Attribute values are indexed by their typed encoding, so that index entries match the values that are equal
+// (see attributeValuesEqual), e.g. 3 and 3.0 have distinct index entries.
+func getAttributeIndexValue(key string, value interface{}) (string, bool) {
+	switch value.(type) {
+	case nil, string, bool, float64, float32, int, int32, int64, uint64:
+		var buffer bytes.Buffer
+		if err := writeAttributeValue(&buffer, value); err != nil {
+			return "", false
+		}
+
+		return key + "\x00" + buffer.String(), true
+	}
+
+	// Only scalar values are indexed.
+	return "", false
+}
+
+func getIndexKeys(record Record, metadata RecordMetadata) [][]byte {
+	keys := [][]byte{
+		getIndexPrefix(typeIndexPrefix, record.Type),
+		getIndexPrefix(updateHeightIndexPrefix, getHeightSortKey(metadata.UpdateHeight)),
+	}
+
+	for _, owner := range record.GetOwners() {
+		keys = append(keys, getIndexPrefix(ownerIndexPrefix, owner))
+	}
+
+	for key, value := range record.Attributes {
+		if indexValue, ok := getAttributeIndexValue(key, value); ok {
+			keys = append(keys, getIndexPrefix(attrIndexPrefix, indexValue))
+		}
+	}
+
+	for _, link := range record.Links {
+		keys = append(keys, getIndexPrefix(linkIndexPrefix, string(link.ID)))
+	}
+
+	for index, key := range keys {
+		keys[index] = append(key, []byte(record.ID)...)
+	}
+
+	return keys
+}
+
+func (k Keeper) addIndexes(ctx sdk.Context, record Record, metadata RecordMetadata) {
+	store := ctx.KVStore(k.indexStoreKey)
+	for _, key := range getIndexKeys(record, metadata) {
+		store.Set(key, []byte{})
+	}
+}
+
+func (k Keeper) removeIndexes(ctx sdk.Context, record Record, metadata RecordMetadata) {
+	store := ctx.KVStore(k.indexStoreKey)
+	for _, key := range getIndexKeys(record, metadata) {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) getIndexedIDs(ctx sdk.Context, prefix []byte) []ID {
+	var ids []ID
+
+	store := ctx.KVStore(k.indexStoreKey)
+	itr := sdk.KVStorePrefixIterator(store, prefix)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		ids = append(ids, ID(itr.Key()[len(prefix):]))
+	}
+
+	return ids
+}
+
+// GetIDsByType - gets the IDs of records of the given type, from the index.
+func (k Keeper) GetIDsByType(ctx sdk.Context, recordType string) []ID {
+	return k.getIndexedIDs(ctx, getIndexPrefix(typeIndexPrefix, recordType))
+}
+
+// IsTypeInUse - checks if there are records of the given type, from the index.
+func (k Keeper) IsTypeInUse(ctx sdk.Context, recordType string) bool {
+	store := ctx.KVStore(k.indexStoreKey)
+	itr := sdk.KVStorePrefixIterator(store, getIndexPrefix(typeIndexPrefix, recordType))
+	defer itr.Close()
+
+	return itr.Valid()
+}
+
+// GetIDsByOwner - gets the IDs of records with the given owner, from the index.
+func (k Keeper) GetIDsByOwner(ctx sdk.Context, owner string) []ID {
+	return k.getIndexedIDs(ctx, getIndexPrefix(ownerIndexPrefix, owner))
+}
+
+// GetLinkingIDs - gets the IDs of records that link to the given record, from the index.
+func (k Keeper) GetLinkingIDs(ctx sdk.Context, id ID) []ID {
+	return k.getIndexedIDs(ctx, getIndexPrefix(linkIndexPrefix, string(id)))
+}
+
+// GetIDsByAttribute - gets the IDs of records with the given (scalar) attribute value, from the index.
+func (k Keeper) GetIDsByAttribute(ctx sdk.Context, key string, value interface{}) []ID {
+	indexValue, ok := getAttributeIndexValue(key, value)
+	if !ok {
+		return nil
+	}
+
+	return k.getIndexedIDs(ctx, getIndexPrefix(attrIndexPrefix, indexValue))
+}
+
+// MatchResources - gets records matching the query, using the type, owner and attribute indexes,
+// and then checking the filter (if any).
+// An empty query matches all records.
+func (k Keeper) MatchResources(ctx sdk.Context, query RecordQuery) []Record {
+	records := []Record{}
+	k.iterateMatchingRecords(ctx, query, func(record Record) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// CountResources - counts the records matching the query (see MatchResources), up to the given max (0 for no max).
+func (k Keeper) CountResources(ctx sdk.Context, query RecordQuery, max int) int {
+	count := 0
+	k.iterateMatchingRecords(ctx, query, func(record Record) bool {
+		count++
+		return max > 0 && count == max
+	})
+
+	return count
+}
+
+// iterateMatchingRecords calls the handler for the records matching the query, until it returns true.
+func (k Keeper) iterateMatchingRecords(ctx sdk.Context, query RecordQuery, handler func(record Record) (stop bool)) {
+	var idSets [][]ID
+
+	if query.Type != "" {
+		idSets = append(idSets, k.GetIDsByType(ctx, query.Type))
+	}
+
+	if query.Owner != "" {
+		idSets = append(idSets, k.GetIDsByOwner(ctx, query.Owner))
+	}
+
+	// Attributes that aren't indexed (e.g. lists) are only checked on the candidates.
+	for key, value := range query.Attributes {
+		if _, ok := getAttributeIndexValue(key, value); ok {
+			idSets = append(idSets, k.GetIDsByAttribute(ctx, key, value))
+		}
+	}
+
+	if query.Filter != nil {
+		for key, value := range query.Filter.GetIndexedAttributes() {
+			idSets = append(idSets, k.GetIDsByAttribute(ctx, key, value))
+		}
+	}
+
+	// Candidates are checked against the whole query, using the same typed equality as the index.
+	check := func(record Record) bool {
+		// Only read record metadata if the filter needs it.
+		var metadata RecordMetadata
+		if query.Filter != nil && query.Filter.UsesMetadata() {
+			metadata = k.GetRecordMetadata(ctx, record.ID)
+		}
+
+		return query.Matches(record, metadata) && handler(record)
+	}
+
+	if len(idSets) > 0 {
+		for _, id := range intersectIDs(idSets) {
+			if check(k.GetResource(ctx, id)) {
+				return
+			}
+		}
+
+		return
+	}
+
+	store := ctx.KVStore(k.resourceStoreKey)
+	itr := store.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var obj RecordObj
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &obj)
+
+		if check(RecordObjToRecord(obj)) {
+			return
+		}
+	}
+}
+
+// Matches checks if a record matches the query, using the same rules as MatchResources (e.g. to notify
+// subscribers of changes to matching records).
+func (query RecordQuery) Matches(record Record, metadata RecordMetadata) bool {
+	if query.Type != "" && record.Type != query.Type {
+		return false
+	}
+
+	if query.Owner != "" && !containsString(record.GetOwners(), query.Owner) {
+		return false
+	}
+
+	for key, value := range query.Attributes {
+		recordValue, exists := record.Attributes[key]
+		if !exists || !attributeValuesEqual(recordValue, value) {
+			return false
+		}
+	}
+
+	return query.Filter == nil || query.Filter.Matches(record, metadata)
+}
+
+// QueryResources - gets a page of records matching the query, in the requested order.
+// Records are read in that order from the cursor (see iterateOrderedRecords) until the page is full, so only
+// counting the records matching the query reads all of them (up to MaxTotalCount).
+func (k Keeper) QueryResources(ctx sdk.Context, query RecordQuery, page PageRequest) (RecordPage, error) {
+	if err := page.Validate(); err != nil {
+		return RecordPage{}, err
+	}
+
+	result := RecordPage{Records: []Record{}}
+	k.iterateOrderedRecords(ctx, query, page, func(record Record, cursorKey string) bool {
+		if page.First > 0 && len(result.Records) == page.First {
+			result.HasNextPage = true
+			return true
+		}
+
+		result.Records = append(result.Records, record)
+		result.EndCursor = encodeCursor(cursorKey)
+
+		return false
+	})
+
+	if page.Count {
+		count := k.CountResources(ctx, query, MaxTotalCount)
+		result.TotalCount = &count
+	}
+
+	return result, nil
+}
+
+// iterateOrderedRecords calls the handler for the records matching the query, in the requested order (with their
+// cursor keys), starting after the page cursor, until the handler returns true. Records are iterated in the type,
+// owner or update height index (of the query type, if set, when ordering by type), or in ID order in the index of the
+// query type or owner, if set, or else the record store.
+func (k Keeper) iterateOrderedRecords(ctx sdk.Context, query RecordQuery, page PageRequest, handler func(record Record, cursorKey string) (stop bool)) {
+	store := ctx.KVStore(k.indexStoreKey)
+
+	// Keys are the index prefix and the cursor key, or for ID order, the ID prefix and the ID.
+	var prefix, rangePrefix, idPrefix []byte
+	switch page.OrderBy {
+	case OrderByType:
+		prefix = typeIndexPrefix
+		if query.Type != "" {
+			rangePrefix = getIndexPrefix(typeIndexPrefix, query.Type)
+		}
+	case OrderByOwner:
+		prefix = ownerIndexPrefix
+	case OrderByUpdateHeight:
+		prefix = updateHeightIndexPrefix
+	default:
+		// The IDs of a type or owner are in ID order in its index.
+		switch {
+		case query.Type != "":
+			idPrefix = getIndexPrefix(typeIndexPrefix, query.Type)
+		case query.Owner != "":
+			idPrefix = getIndexPrefix(ownerIndexPrefix, query.Owner)
+		default:
+			store = ctx.KVStore(k.resourceStoreKey)
+		}
+	}
+
+	if rangePrefix == nil {
+		rangePrefix = prefix
+	}
+
+	if prefix == nil {
+		rangePrefix = idPrefix
+	}
+
+	// Index keys are the cursor keys, after the index prefix. For ID order, cursor keys have an empty sort key.
+	getKey := func(cursorKey string) []byte {
+		if prefix == nil {
+			return append(append([]byte{}, idPrefix...), []byte(getCursorID(cursorKey))...)
+		}
+
+		return append(append([]byte{}, prefix...), []byte(cursorKey)...)
+	}
+
+	getKeyCursorKey := func(key []byte) string {
+		if prefix == nil {
+			return getCursorKey("", ID(key[len(idPrefix):]))
+		}
+
+		return string(key[len(prefix):])
+	}
+
+	start, end := rangePrefix, sdk.PrefixEndBytes(rangePrefix)
+
+	after := ""
+	if page.After != "" {
+		// Cursors are checked by PageRequest.Validate.
+		after, _ = decodeCursor(page.After)
+
+		afterKey := getKey(after)
+		if page.Descending && (end == nil || bytes.Compare(afterKey, end) < 0) {
+			end = afterKey
+		}
+
+		if !page.Descending && bytes.Compare(afterKey, start) > 0 {
+			start = afterKey
+		}
+	}
+
+	var itr sdk.Iterator
+	if page.Descending {
+		itr = store.ReverseIterator(start, end)
+	} else {
+		itr = store.Iterator(start, end)
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		cursorKey := getKeyCursorKey(itr.Key())
+		if cursorKey == after {
+			continue
+		}
+
+		id := getCursorID(cursorKey)
+		if !k.HasResource(ctx, id) {
+			continue
+		}
+
+		record := k.GetResource(ctx, id)
+
+		// Records with several owners are in the owner index once per owner, but are ordered by their first owner.
+		if page.OrderBy == OrderByOwner && cursorKey != getCursorKey(record.Owner, id) {
+			continue
+		}
+
+		// Only read record metadata if the filter needs it.
+		var metadata RecordMetadata
+		if query.Filter != nil && query.Filter.UsesMetadata() {
+			metadata = k.GetRecordMetadata(ctx, id)
+		}
+
+		if !query.Matches(record, metadata) {
+			continue
+		}
+
+		if handler(record, cursorKey) {
+			break
+		}
+	}
+}
+
+// intersectIDs returns the IDs present in all the given sets, in the order of the first set.
+func intersectIDs(idSets [][]ID) []ID {
+	counts := make(map[ID]int)
+	for _, ids := range idSets {
+		for _, id := range ids {
+			counts[id]++
+		}
+	}
+
+	var result []ID
+	for _, id := range idSets[0] {
+		if counts[id] == len(idSets) {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// getTxHash returns the hash of the tx being processed, as reported by Tendermint.
+func getTxHash(ctx sdk.Context) string {
+	txBytes := ctx.TxBytes()
+	if len(txBytes) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%X", tmhash.Sum(txBytes))
+}
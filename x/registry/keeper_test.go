@@ -0,0 +1,144 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/wirelineio/registry/x/admin"
+)
+
+// testInput has a registry keeper backed by in-memory stores, and a context at height 1.
+type testInput struct {
+	ctx    sdk.Context
+	keeper Keeper
+}
+
+func setupTestInput(t *testing.T) testInput {
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	keyAccount := sdk.NewKVStoreKey("acc")
+	keyFeeCollection := sdk.NewKVStoreKey("fee_collection")
+	keyParams := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	keyAdmin := sdk.NewKVStoreKey("admin")
+	keyRecord := sdk.NewKVStoreKey("registry")
+	keyRevision := sdk.NewKVStoreKey("registry_revision")
+	keyIndex := sdk.NewKVStoreKey("registry_index")
+	keyName := sdk.NewKVStoreKey("registry_name")
+	keyMetadata := sdk.NewKVStoreKey("registry_metadata")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range []sdk.StoreKey{keyAccount, keyFeeCollection, keyParams, keyAdmin, keyRecord, keyRevision, keyIndex, keyName, keyMetadata} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAccount, auth.ProtoBaseAccount)
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	keeper := NewKeeper(accountKeeper, admin.NewKeeper(keyAdmin, cdc), bank.NewBaseKeeper(accountKeeper),
+		auth.NewFeeCollectionKeeper(cdc, keyFeeCollection), keyRecord, keyRevision, keyIndex, keyName, keyMetadata,
+		paramsKeeper.Subspace(DefaultParamspace), cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: WirelineChainID, Height: 1, Time: time.Unix(1554076800, 0)}, false, log.NewNopLogger())
+	keeper.SetParams(ctx, DefaultParams())
+
+	return testInput{ctx: ctx, keeper: keeper}
+}
+
+// putTestRecord writes a record at the given height.
+func (input testInput) putTestRecord(height int64, record Record) Record {
+	input.keeper.PutResource(input.ctx.WithBlockHeight(height), record)
+
+	return input.keeper.GetResource(input.ctx, record.ID)
+}
+
+func newTestRecord(id string, recordType string, owner string, attributes map[string]interface{}) Record {
+	return Record{ID: ID(id), Type: recordType, Owner: owner, Attributes: attributes}
+}
+
+func getRecordIDs(records []Record) []ID {
+	ids := []ID{}
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+
+	return ids
+}
+
+func TestKeeperIndexes(t *testing.T) {
+	input := setupTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	input.putTestRecord(1, newTestRecord("wrn:record:1", "wrn:registry-type:a", "owner1", map[string]interface{}{"n": int64(1), "x": nil}))
+	input.putTestRecord(1, newTestRecord("wrn:record:2", "wrn:registry-type:a", "owner2", map[string]interface{}{"n": 1.0}))
+	record := newTestRecord("wrn:record:3", "wrn:registry-type:b", "owner1", map[string]interface{}{"n": int64(1)})
+	record.Links = []Link{{ID: "wrn:record:1"}}
+	input.putTestRecord(1, record)
+
+	tests := []struct {
+		name string
+		ids  []ID
+		want []ID
+	}{
+		{"type", keeper.GetIDsByType(ctx, "wrn:registry-type:a"), []ID{"wrn:record:1", "wrn:record:2"}},
+		{"owner", keeper.GetIDsByOwner(ctx, "owner1"), []ID{"wrn:record:1", "wrn:record:3"}},
+		{"int attribute", keeper.GetIDsByAttribute(ctx, "n", int64(1)), []ID{"wrn:record:1", "wrn:record:3"}},
+		{"float attribute", keeper.GetIDsByAttribute(ctx, "n", 1.0), []ID{"wrn:record:2"}},
+		{"null attribute", keeper.GetIDsByAttribute(ctx, "x", nil), []ID{"wrn:record:1"}},
+		{"links", keeper.GetLinkingIDs(ctx, "wrn:record:1"), []ID{"wrn:record:3"}},
+	}
+
+	for _, test := range tests {
+		if fmt.Sprint(test.ids) != fmt.Sprint(test.want) {
+			t.Errorf("%s index = %v, want %v", test.name, test.ids, test.want)
+		}
+	}
+
+	// Overwriting and deleting records updates the indexes.
+	input.putTestRecord(2, newTestRecord("wrn:record:1", "wrn:registry-type:b", "owner2", nil))
+	keeper.DeleteResource(ctx, "wrn:record:3")
+
+	tests = []struct {
+		name string
+		ids  []ID
+		want []ID
+	}{
+		{"type", keeper.GetIDsByType(ctx, "wrn:registry-type:a"), []ID{"wrn:record:2"}},
+		{"owner", keeper.GetIDsByOwner(ctx, "owner1"), []ID{}},
+		{"int attribute", keeper.GetIDsByAttribute(ctx, "n", int64(1)), []ID{}},
+		{"links", keeper.GetLinkingIDs(ctx, "wrn:record:1"), []ID{}},
+	}
+
+	for _, test := range tests {
+		if fmt.Sprint(test.ids) != fmt.Sprint(test.want) {
+			t.Errorf("%s index after writes = %v, want %v", test.name, test.ids, test.want)
+		}
+	}
+
+	if version := keeper.GetResource(ctx, "wrn:record:1").Version; version != 2 {
+		t.Errorf("record version = %d, want 2", version)
+	}
+}
@@ -6,6 +6,9 @@ package registry
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,13 +17,25 @@ import (
 type MsgSetRecord struct {
 	Payload PayloadObj
 	Signer  sdk.AccAddress
+
+	// Allow links to records that don't exist (yet).
+	AllowDanglingLinks bool
+
+	// Only write if the record is currently at this version (0 to skip the check).
+	ExpectedVersion uint64
+
+	// Only write if the record doesn't exist.
+	CreateOnly bool
 }
 
 // NewMsgSetRecord is the constructor function for MsgSetRecord.
-func NewMsgSetRecord(payload PayloadObj, signer sdk.AccAddress) MsgSetRecord {
+func NewMsgSetRecord(payload PayloadObj, signer sdk.AccAddress, allowDanglingLinks bool, expectedVersion uint64, createOnly bool) MsgSetRecord {
 	return MsgSetRecord{
-		Payload: payload,
-		Signer:  signer,
+		Payload:            payload,
+		Signer:             signer,
+		AllowDanglingLinks: allowDanglingLinks,
+		ExpectedVersion:    expectedVersion,
+		CreateOnly:         createOnly,
 	}
 }
 
@@ -37,11 +52,55 @@ func (msg MsgSetRecord) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Signer.String())
 	}
 
+	id := msg.Payload.Record.ID
+	if id == "" {
+		return sdk.ErrInternal("Record ID not set.")
+	}
+
+	if err := validateRecordUTF8(msg.Payload.Record); err != nil {
+		return err
+	}
+
+	if strings.Contains(string(id), VersionSeparator) {
+		return sdk.ErrInternal(fmt.Sprintf("Record ID can't contain '%s'.", VersionSeparator))
+	}
+
 	owner := msg.Payload.Record.Owner
 	if owner == "" {
 		return sdk.ErrInternal("Record owner not set.")
 	}
 
+	if err := validateOwners(msg.Payload.Record); err != nil {
+		return err
+	}
+
+	if msg.Payload.Record.TTL < 0 {
+		return sdk.ErrInternal("Record TTL can't be negative.")
+	}
+
+	if msg.CreateOnly && msg.ExpectedVersion != 0 {
+		return sdk.ErrInternal("Expected version can't be set for create-only writes.")
+	}
+
+	for _, link := range msg.Payload.Record.Links {
+		if link.ID == "" {
+			return sdk.ErrUnknownRequest("Record link ID not set.")
+		}
+	}
+
+	attributes, err := UnMarshalAttributes(msg.Payload.Record.Attributes)
+	if err != nil {
+		return sdk.ErrTxDecode(fmt.Sprintf("Invalid record attributes: %s.", err))
+	}
+
+	if err := validateKeyComponents(msg.Payload.Record, attributes); err != nil {
+		return err
+	}
+
+	if err := validateTypeDefinition(msg.Payload.Record); err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Invalid type definition: %s.", err))
+	}
+
 	return nil
 }
 
@@ -91,6 +150,15 @@ func (msg MsgDeleteRecord) ValidateBasic() sdk.Error {
 		return sdk.ErrInternal("Record owner not set.")
 	}
 
+	if err := validateRecordUTF8(msg.Payload.Record); err != nil {
+		return err
+	}
+
+	// The signed payload includes the attributes, so they must be well formed.
+	if _, err := UnMarshalAttributes(msg.Payload.Record.Attributes); err != nil {
+		return sdk.ErrTxDecode(fmt.Sprintf("Invalid record attributes: %s.", err))
+	}
+
 	return nil
 }
 
@@ -108,6 +176,123 @@ func (msg MsgDeleteRecord) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
 
+// MsgRenewRecord defines a RenewRecord message, which extends the expiry of a record.
+type MsgRenewRecord struct {
+	ID     ID
+	TTL    int64
+	Signer sdk.AccAddress
+}
+
+// NewMsgRenewRecord is the constructor function for MsgRenewRecord.
+func NewMsgRenewRecord(id ID, ttl int64, signer sdk.AccAddress) MsgRenewRecord {
+	return MsgRenewRecord{
+		ID:     id,
+		TTL:    ttl,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRenewRecord) Route() string { return "registry" }
+
+// Type Implements Msg.
+func (msg MsgRenewRecord) Type() string { return "renew" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRenewRecord) ValidateBasic() sdk.Error {
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	if msg.ID == "" {
+		return sdk.ErrInternal("Record ID not set.")
+	}
+
+	if msg.TTL <= 0 {
+		return sdk.ErrInternal("Record TTL must be positive.")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRenewRecord) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRenewRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgTransferRecord defines a TransferRecord message.
+// The transfer must be signed by the current owners and the new owners (see Record.GetThreshold), either in a
+// single message or as an offer by the current owners followed by an acceptance by the new owners.
+type MsgTransferRecord struct {
+	Payload TransferPayload
+	Signer  sdk.AccAddress
+}
+
+// NewMsgTransferRecord is the constructor function for MsgTransferRecord.
+func NewMsgTransferRecord(payload TransferPayload, signer sdk.AccAddress) MsgTransferRecord {
+	return MsgTransferRecord{
+		Payload: payload,
+		Signer:  signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTransferRecord) Route() string { return "registry" }
+
+// Type Implements Msg.
+func (msg MsgTransferRecord) Type() string { return "transfer" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferRecord) ValidateBasic() sdk.Error {
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	transfer := msg.Payload.Transfer
+	if transfer.ID == "" {
+		return sdk.ErrInternal("Record ID not set.")
+	}
+
+	if transfer.Owner == "" {
+		return sdk.ErrInternal("Record owner not set.")
+	}
+
+	if len(msg.Payload.Signatures) == 0 {
+		return sdk.ErrInternal("Transfer signatures not set.")
+	}
+
+	if err := validateUTF8(append([]string{string(transfer.ID), transfer.Owner}, transfer.Owners...)); err != nil {
+		return err
+	}
+
+	return validateOwners(RecordObj{Owner: transfer.Owner, Owners: transfer.Owners, Threshold: transfer.Threshold})
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferRecord) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
 // MsgClearRecords defines a MsgClearRecords message.
 type MsgClearRecords struct {
 	Signer sdk.AccAddress
@@ -149,3 +334,283 @@ func (msg MsgClearRecords) GetSignBytes() []byte {
 func (msg MsgClearRecords) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// MsgReserveName defines a ReserveName message.
+type MsgReserveName struct {
+	Name   string
+	Signer sdk.AccAddress
+}
+
+// NewMsgReserveName is the constructor function for MsgReserveName.
+func NewMsgReserveName(name string, signer sdk.AccAddress) MsgReserveName {
+	return MsgReserveName{
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgReserveName) Route() string { return "registry" }
+
+// Type Implements Msg.
+func (msg MsgReserveName) Type() string { return "reserve-name" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgReserveName) ValidateBasic() sdk.Error {
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	return validateName(msg.Name)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgReserveName) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgReserveName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgSetName defines a SetName message, which points a name at a record.
+type MsgSetName struct {
+	Name   string
+	ID     ID
+	Signer sdk.AccAddress
+}
+
+// NewMsgSetName is the constructor function for MsgSetName.
+func NewMsgSetName(name string, id ID, signer sdk.AccAddress) MsgSetName {
+	return MsgSetName{
+		Name:   name,
+		ID:     id,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetName) Route() string { return "registry" }
+
+// Type Implements Msg.
+func (msg MsgSetName) Type() string { return "set-name" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetName) ValidateBasic() sdk.Error {
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	if msg.ID == "" {
+		return sdk.ErrInternal("Record ID not set.")
+	}
+
+	return validateName(msg.Name)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetName) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgTransferName defines a TransferName message.
+type MsgTransferName struct {
+	Name     string
+	NewOwner sdk.AccAddress
+	Signer   sdk.AccAddress
+}
+
+// NewMsgTransferName is the constructor function for MsgTransferName.
+func NewMsgTransferName(name string, newOwner sdk.AccAddress, signer sdk.AccAddress) MsgTransferName {
+	return MsgTransferName{
+		Name:     name,
+		NewOwner: newOwner,
+		Signer:   signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTransferName) Route() string { return "registry" }
+
+// Type Implements Msg.
+func (msg MsgTransferName) Type() string { return "transfer-name" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferName) ValidateBasic() sdk.Error {
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	if msg.NewOwner.Empty() {
+		return sdk.ErrInvalidAddress(msg.NewOwner.String())
+	}
+
+	return validateName(msg.Name)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferName) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgReleaseName defines a ReleaseName message.
+type MsgReleaseName struct {
+	Name   string
+	Signer sdk.AccAddress
+}
+
+// NewMsgReleaseName is the constructor function for MsgReleaseName.
+func NewMsgReleaseName(name string, signer sdk.AccAddress) MsgReleaseName {
+	return MsgReleaseName{
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgReleaseName) Route() string { return "registry" }
+
+// Type Implements Msg.
+func (msg MsgReleaseName) Type() string { return "release-name" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgReleaseName) ValidateBasic() sdk.Error {
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	return validateName(msg.Name)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgReleaseName) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgReleaseName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// validateOwners checks the additional owners and signature threshold of a record.
+func validateOwners(record RecordObj) sdk.Error {
+	for _, owner := range record.Owners {
+		if owner == "" {
+			return sdk.ErrInternal("Record owner not set.")
+		}
+	}
+
+	// Owners are indexed (see validateKeyComponents).
+	for _, owner := range (Record{Owner: record.Owner, Owners: record.Owners}).GetOwners() {
+		if strings.Contains(owner, "\x00") {
+			return sdk.ErrInternal("Record owners can't contain '\\x00'.")
+		}
+	}
+
+	if record.Threshold < 0 {
+		return sdk.ErrInternal("Record threshold can't be negative.")
+	}
+
+	if owners := len(Record{Owner: record.Owner, Owners: record.Owners}.GetOwners()); record.Threshold > owners {
+		return sdk.ErrInternal(fmt.Sprintf("Record threshold can't exceed the number of owners (%d).", owners))
+	}
+
+	return nil
+}
+
+// validateRecordUTF8 checks that the strings of a record payload (including its JSON encoded attributes) are
+// valid UTF-8 (see validateUTF8).
+func validateRecordUTF8(record RecordObj) sdk.Error {
+	values := append([]string{string(record.ID), record.Type, record.Owner}, record.Owners...)
+	for _, link := range record.Links {
+		values = append(values, string(link.ID), link.Label)
+	}
+
+	if err := validateUTF8(values); err != nil {
+		return err
+	}
+
+	if !utf8.Valid(record.Attributes) {
+		return sdk.ErrInternal("Record attributes must be valid UTF-8.")
+	}
+
+	return nil
+}
+
+// validateUTF8 checks that the strings of a signed payload are valid UTF-8. Sign bytes are generated from the JSON
+// encoding of the payload, which replaces invalid UTF-8 (see SignFormatVersion), so payloads that only differ in
+// invalid bytes would otherwise have the same sign bytes.
+func validateUTF8(values []string) sdk.Error {
+	for _, value := range values {
+		if !utf8.ValidString(value) {
+			return sdk.ErrInternal("Payload strings must be valid UTF-8.")
+		}
+	}
+
+	return nil
+}
+
+// validateKeyComponents checks that the record ID, type, attribute keys and link IDs don't contain the \x00 separator
+// of index keys (see getIndexPrefix), so that the index keys of different values can't collide.
+func validateKeyComponents(record RecordObj, attributes map[string]interface{}) sdk.Error {
+	values := []string{string(record.ID), record.Type}
+	for key := range attributes {
+		values = append(values, key)
+	}
+
+	for _, link := range record.Links {
+		values = append(values, string(link.ID))
+	}
+
+	for _, value := range values {
+		if strings.Contains(value, "\x00") {
+			return sdk.ErrInternal("Record ID, type, attribute keys and link IDs can't contain '\\x00'.")
+		}
+	}
+
+	return nil
+}
+
+// validateName checks that a name is of the form wrn://<path>.
+func validateName(name string) sdk.Error {
+	if !strings.HasPrefix(name, NamePrefix) || len(name) == len(NamePrefix) {
+		return sdk.ErrInternal(fmt.Sprintf("Invalid name, must be of the form %s<path>.", NamePrefix))
+	}
+
+	if strings.HasSuffix(name, "/") || strings.Contains(name[len(NamePrefix):], "//") {
+		return sdk.ErrInternal("Invalid name, path can't contain empty segments.")
+	}
+
+	return nil
+}
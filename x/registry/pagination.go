@@ -0,0 +1,124 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Record orderings.
+const (
+	OrderByID           = "id"
+	OrderByType         = "type"
+	OrderByOwner        = "owner"
+	OrderByUpdateHeight = "updateHeight"
+)
+
+// Page sizes of the list querier and GQL: the default if `first` isn't set, and the max.
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// MaxTotalCount is the max number of records counted for the total count of a query.
+// Larger counts are returned as MaxTotalCount.
+const MaxTotalCount = 10000
+
+// PageRequest represents a request for a page of records.
+// Cursors are opaque strings, returned as the EndCursor of the previous page.
+type PageRequest struct {
+	// Max number of records to return (0 for all, see WithDefaultPageSize).
+	First int `json:"first,omitempty"`
+	// Return records after this cursor.
+	After string `json:"after,omitempty"`
+	// Order records by this field (defaults to ID), then ID.
+	OrderBy    string `json:"orderBy,omitempty"`
+	Descending bool   `json:"descending,omitempty"`
+	// Also count the records matching the query (in all pages, up to MaxTotalCount), which reads all of them.
+	Count bool `json:"count,omitempty"`
+}
+
+// RecordPage represents a page of records.
+type RecordPage struct {
+	Records []Record `json:"records"`
+	// Number of records matching the query (in all pages, up to MaxTotalCount), if requested.
+	TotalCount  *int   `json:"totalCount,omitempty"`
+	EndCursor   string `json:"endCursor,omitempty"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// ListRecordsRequest represents a request to the list querier.
+type ListRecordsRequest struct {
+	RecordQuery
+	PageRequest
+}
+
+// Validate checks that the page request is well formed.
+func (page PageRequest) Validate() error {
+	if page.First < 0 {
+		return errors.New("first must not be negative")
+	}
+
+	if page.First > MaxPageSize {
+		return fmt.Errorf("first must not exceed %d", MaxPageSize)
+	}
+
+	switch page.OrderBy {
+	case "", OrderByID, OrderByType, OrderByOwner, OrderByUpdateHeight:
+	default:
+		return fmt.Errorf("invalid order by '%s'", page.OrderBy)
+	}
+
+	if page.After != "" {
+		if _, err := decodeCursor(page.After); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WithDefaultPageSize returns the page request with the default page size, if `first` isn't set.
+// Queries from clients (the list querier and GQL) use it, so that they don't read all records.
+func (page PageRequest) WithDefaultPageSize() PageRequest {
+	if page.First == 0 {
+		page.First = DefaultPageSize
+	}
+
+	return page
+}
+
+// getCursorKey returns the key records are sorted by (the sort key, then the ID).
+// These are also the keys of the type, owner and update height indexes (after their prefix).
+func getCursorKey(sortKey string, id ID) string {
+	return strings.Join([]string{sortKey, string(id)}, "\x00")
+}
+
+// getCursorID returns the record ID of a cursor key.
+func getCursorID(key string) ID {
+	return ID(key[strings.LastIndex(key, "\x00")+1:])
+}
+
+// getHeightSortKey returns a sort key that orders heights numerically (including heights of writes imported
+// from genesis, which are zero or negative).
+func getHeightSortKey(height int64) string {
+	return fmt.Sprintf("%020d", uint64(height)^(1<<63))
+}
+
+func encodeCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeCursor(cursor string) (string, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.Contains(string(bz), "\x00") {
+		return "", errors.New("invalid cursor")
+	}
+
+	return string(bz), nil
+}
@@ -0,0 +1,222 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	for _, key := range []string{getCursorKey("", "wrn:record:1"), getCursorKey("wrn:registry-type:a", "wrn:record:1"), getCursorKey(getHeightSortKey(-5), "x")} {
+		cursor := encodeCursor(key)
+
+		decoded, err := decodeCursor(cursor)
+		if err != nil {
+			t.Fatalf("decodeCursor(%s): %s", cursor, err)
+		}
+
+		if decoded != key {
+			t.Errorf("decodeCursor(encodeCursor(%q)) = %q", key, decoded)
+		}
+	}
+
+	if id := getCursorID(getCursorKey("owner\x00with separator", "wrn:record:1")); id != "wrn:record:1" {
+		t.Errorf("getCursorID = %s, want wrn:record:1", id)
+	}
+
+	for _, cursor := range []string{"not base64!", encodeCursor("no separator")} {
+		if _, err := decodeCursor(cursor); err == nil {
+			t.Errorf("expected cursor %s to be invalid", cursor)
+		}
+	}
+}
+
+func TestHeightSortKey(t *testing.T) {
+	heights := []int64{-1 << 40, -100, -1, 0, 1, 9, 10, 1 << 40}
+	for index := 1; index < len(heights); index++ {
+		if getHeightSortKey(heights[index-1]) >= getHeightSortKey(heights[index]) {
+			t.Errorf("height %d isn't sorted before %d", heights[index-1], heights[index])
+		}
+	}
+}
+
+func TestPageRequestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		page  PageRequest
+		valid bool
+	}{
+		{"empty", PageRequest{}, true},
+		{"max page size", PageRequest{First: MaxPageSize}, true},
+		{"over max page size", PageRequest{First: MaxPageSize + 1}, false},
+		{"negative first", PageRequest{First: -1}, false},
+		{"order by", PageRequest{OrderBy: OrderByUpdateHeight, Descending: true}, true},
+		{"invalid order by", PageRequest{OrderBy: "attributes"}, false},
+		{"cursor", PageRequest{After: encodeCursor(getCursorKey("", "wrn:record:1"))}, true},
+		{"invalid cursor", PageRequest{After: "wrn:record:1"}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.page.Validate()
+			if test.valid && err != nil {
+				t.Errorf("expected a valid page request, got %s", err)
+			}
+
+			if !test.valid && err == nil {
+				t.Error("expected an invalid page request")
+			}
+		})
+	}
+
+	if first := (PageRequest{}).WithDefaultPageSize().First; first != DefaultPageSize {
+		t.Errorf("default page size = %d, want %d", first, DefaultPageSize)
+	}
+}
+
+func TestQueryResourcesPaging(t *testing.T) {
+	input := setupTestInput(t)
+
+	heights := make(map[ID]int64)
+	var records []Record
+	for index := 0; index < 12; index++ {
+		record := newTestRecord(fmt.Sprintf("wrn:record:%02d", (index*7)%12), fmt.Sprintf("wrn:registry-type:%d", index%3),
+			fmt.Sprintf("owner%d", index%4), map[string]interface{}{"even": index%2 == 0})
+
+		// Some records share the second owner, so that they're in the owner index more than once.
+		if index%5 == 0 {
+			record.Owners = []string{record.Owner, "owner1"}
+		}
+
+		// Heights aren't in ID order, and some records are written at the same height.
+		height := int64(12 - index/2)
+		heights[record.ID] = height
+		records = append(records, input.putTestRecord(height, record))
+	}
+
+	// Records sorted by the given sort key, then ID.
+	sortedIDs := func(sortKey func(record Record) string, descending bool, match func(record Record) bool) []ID {
+		var matching []Record
+		for _, record := range records {
+			if match(record) {
+				matching = append(matching, record)
+			}
+		}
+
+		sort.Slice(matching, func(i, j int) bool {
+			less := getCursorKey(sortKey(matching[i]), matching[i].ID) < getCursorKey(sortKey(matching[j]), matching[j].ID)
+			if descending {
+				return !less
+			}
+
+			return less
+		})
+
+		return getRecordIDs(matching)
+	}
+
+	byID := func(record Record) string { return "" }
+	byType := func(record Record) string { return record.Type }
+	byOwner := func(record Record) string { return record.Owner }
+	byHeight := func(record Record) string { return getHeightSortKey(heights[record.ID]) }
+
+	all := func(record Record) bool { return true }
+	ofType := func(record Record) bool { return record.Type == "wrn:registry-type:1" }
+	ofOwner := func(record Record) bool {
+		return containsString(record.GetOwners(), "owner1")
+	}
+	even := func(record Record) bool { return record.Attributes["even"] == true }
+
+	evenFilter := &Filter{Key: "even", Op: FilterOpEq, Value: true}
+
+	tests := []struct {
+		name  string
+		query RecordQuery
+		page  PageRequest
+		want  []ID
+	}{
+		{"id", RecordQuery{}, PageRequest{}, sortedIDs(byID, false, all)},
+		{"id descending", RecordQuery{}, PageRequest{Descending: true}, sortedIDs(byID, true, all)},
+		{"id of type", RecordQuery{Type: "wrn:registry-type:1"}, PageRequest{}, sortedIDs(byID, false, ofType)},
+		{"id of owner", RecordQuery{Owner: "owner1"}, PageRequest{Descending: true}, sortedIDs(byID, true, ofOwner)},
+		{"type", RecordQuery{}, PageRequest{OrderBy: OrderByType}, sortedIDs(byType, false, all)},
+		{"type of type", RecordQuery{Type: "wrn:registry-type:1"}, PageRequest{OrderBy: OrderByType, Descending: true}, sortedIDs(byType, true, ofType)},
+		{"owner", RecordQuery{}, PageRequest{OrderBy: OrderByOwner}, sortedIDs(byOwner, false, all)},
+		{"owner descending", RecordQuery{}, PageRequest{OrderBy: OrderByOwner, Descending: true}, sortedIDs(byOwner, true, all)},
+		{"update height", RecordQuery{}, PageRequest{OrderBy: OrderByUpdateHeight}, sortedIDs(byHeight, false, all)},
+		{"update height descending filtered", RecordQuery{Filter: evenFilter}, PageRequest{OrderBy: OrderByUpdateHeight, Descending: true}, sortedIDs(byHeight, true, even)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			// All records in one page.
+			page, err := input.keeper.QueryResources(input.ctx, test.query, test.page)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if fmt.Sprint(getRecordIDs(page.Records)) != fmt.Sprint(test.want) || page.HasNextPage {
+				t.Fatalf("records = %v (has next page %v), want %v", getRecordIDs(page.Records), page.HasNextPage, test.want)
+			}
+
+			// The same records, a few at a time.
+			for _, pageSize := range []int{1, 2, 5} {
+				pageRequest := test.page
+				pageRequest.First = pageSize
+
+				ids := []ID{}
+				for pages := 0; ; pages++ {
+					if pages > len(records) {
+						t.Fatalf("page size %d: too many pages", pageSize)
+					}
+
+					page, err := input.keeper.QueryResources(input.ctx, test.query, pageRequest)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if len(page.Records) > pageSize {
+						t.Fatalf("page size %d: got %d records", pageSize, len(page.Records))
+					}
+
+					ids = append(ids, getRecordIDs(page.Records)...)
+					if !page.HasNextPage {
+						break
+					}
+
+					pageRequest.After = page.EndCursor
+				}
+
+				if fmt.Sprint(ids) != fmt.Sprint(test.want) {
+					t.Errorf("page size %d: records = %v, want %v", pageSize, ids, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryResourcesCount(t *testing.T) {
+	input := setupTestInput(t)
+	for index := 0; index < 5; index++ {
+		input.putTestRecord(1, newTestRecord(fmt.Sprintf("wrn:record:%d", index), "wrn:registry-type:a", "owner1", nil))
+	}
+
+	page, err := input.keeper.QueryResources(input.ctx, RecordQuery{}, PageRequest{First: 2, Count: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Records) != 2 || !page.HasNextPage || page.TotalCount == nil || *page.TotalCount != 5 {
+		t.Errorf("page = %d records, has next page %v, total count %v, want 2 records of 5", len(page.Records), page.HasNextPage, page.TotalCount)
+	}
+
+	if _, err := input.keeper.QueryResources(input.ctx, RecordQuery{}, PageRequest{First: MaxPageSize + 1}); err == nil {
+		t.Error("expected an error for a page size over the max")
+	}
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// DefaultParamspace is the param subspace of the registry module.
+const DefaultParamspace = "registry"
+
+// Default registry params.
+const (
+	DefaultMaxAttributeSize int64 = 64 * 1024
+	DefaultMaxTTL           int64 = 10000000
+	DefaultDenom                  = "wire"
+	DefaultRentPerBlock     int64 = 0
+	DefaultFeePerByte       int64 = 0
+)
+
+// DefaultFeeRefundRate is the default fraction of the record write fee refunded when the record is deleted.
+var DefaultFeeRefundRate = sdk.ZeroDec()
+
+// Keys for registry params.
+var (
+	KeyMaxAttributeSize    = []byte("MaxAttributeSize")
+	KeyMaxRecordsPerOwner  = []byte("MaxRecordsPerOwner")
+	KeyAllowedTypePrefixes = []byte("AllowedTypePrefixes")
+	KeyMaxTTL              = []byte("MaxTTL")
+	KeyRentPerBlock        = []byte("RentPerBlock")
+	KeyFeePerByte          = []byte("FeePerByte")
+	KeyFeeRefundRate       = []byte("FeeRefundRate")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params defines the registry limits and fees.
+type Params struct {
+	// Max size (in bytes) of the serialized record attributes (0 for no limit).
+	MaxAttributeSize int64 `json:"max_attribute_size"`
+	// Max number of records owned by an account (0 for no limit).
+	MaxRecordsPerOwner int64 `json:"max_records_per_owner"`
+	// Record types must start with one of these prefixes (any type is allowed if empty).
+	// Type definitions are always allowed.
+	AllowedTypePrefixes []string `json:"allowed_type_prefixes"`
+	// Max number of blocks a record can be kept for, from the current block (by a TTL or a renewal).
+	MaxTTL int64 `json:"max_ttl"`
+	// Rent charged for keeping a record in the store for a block.
+	RentPerBlock sdk.Coin `json:"rent_per_block"`
+	// Fee charged per byte of the serialized record, for each record write.
+	FeePerByte sdk.Coin `json:"fee_per_byte"`
+	// Fraction (between 0 and 1) of the record write fee held in escrow, and refunded when the record is deleted.
+	FeeRefundRate sdk.Dec `json:"fee_refund_rate"`
+}
+
+// KeyValuePairs implements params.ParamSet.
+func (p *Params) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{Key: KeyMaxAttributeSize, Value: &p.MaxAttributeSize},
+		{Key: KeyMaxRecordsPerOwner, Value: &p.MaxRecordsPerOwner},
+		{Key: KeyAllowedTypePrefixes, Value: &p.AllowedTypePrefixes},
+		{Key: KeyMaxTTL, Value: &p.MaxTTL},
+		{Key: KeyRentPerBlock, Value: &p.RentPerBlock},
+		{Key: KeyFeePerByte, Value: &p.FeePerByte},
+		{Key: KeyFeeRefundRate, Value: &p.FeeRefundRate},
+	}
+}
+
+// ParamTypeTable returns the param type table of the registry module.
+func ParamTypeTable() params.TypeTable {
+	return params.NewTypeTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default registry params.
+func DefaultParams() Params {
+	return Params{
+		MaxAttributeSize:    DefaultMaxAttributeSize,
+		AllowedTypePrefixes: []string{},
+		MaxTTL:              DefaultMaxTTL,
+		RentPerBlock:        sdk.NewInt64Coin(DefaultDenom, DefaultRentPerBlock),
+		FeePerByte:          sdk.NewInt64Coin(DefaultDenom, DefaultFeePerByte),
+		FeeRefundRate:       DefaultFeeRefundRate,
+	}
+}
+
+// Validate checks that the params are well formed.
+func (p Params) Validate() error {
+	if p.MaxAttributeSize < 0 {
+		return fmt.Errorf("max attribute size must not be negative")
+	}
+
+	if p.MaxRecordsPerOwner < 0 {
+		return fmt.Errorf("max records per owner must not be negative")
+	}
+
+	for _, prefix := range p.AllowedTypePrefixes {
+		if prefix == "" {
+			return fmt.Errorf("allowed type prefixes must not be empty")
+		}
+	}
+
+	if p.MaxTTL <= 0 {
+		return fmt.Errorf("max TTL must be positive")
+	}
+
+	if p.RentPerBlock.Denom == "" || p.RentPerBlock.Amount == (sdk.Int{}) || !p.RentPerBlock.IsNotNegative() {
+		return fmt.Errorf("rent per block must be a non-negative amount, with a denomination")
+	}
+
+	if p.FeePerByte.Denom == "" || p.FeePerByte.Amount == (sdk.Int{}) || !p.FeePerByte.IsNotNegative() {
+		return fmt.Errorf("fee per byte must be a non-negative amount, with a denomination")
+	}
+
+	if p.FeeRefundRate.IsNil() || p.FeeRefundRate.IsNegative() || p.FeeRefundRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee refund rate must be between 0 and 1")
+	}
+
+	return nil
+}
+
+// IsTypeAllowed checks if records of the given type can be written.
+func (p Params) IsTypeAllowed(recordType string) bool {
+	if len(p.AllowedTypePrefixes) == 0 || recordType == TypeDefinitionType {
+		return true
+	}
+
+	for _, prefix := range p.AllowedTypePrefixes {
+		if strings.HasPrefix(recordType, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetParams - gets the registry params.
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams - saves the registry params.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -6,11 +6,12 @@ package registry
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/emicklei/dot"
-	"github.com/golang-collections/collections/stack"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -18,8 +19,11 @@ import (
 const (
 	ListResources = "list"
 	GetResource   = "get"
+	GetHistory    = "history"
 	GetGraph      = "graph"
+	ResolveName   = "resolve"
 	GetTest       = "test"
+	GetParams     = "params"
 )
 
 // NewQuerier is the module level router for state queries
@@ -30,10 +34,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return listResources(ctx, path[1:], req, keeper)
 		case GetResource:
 			return getResource(ctx, path[1:], req, keeper)
+		case GetHistory:
+			return getHistory(ctx, path[1:], req, keeper)
 		case GetGraph:
 			return getGraph(ctx, path[1:], req, keeper)
+		case ResolveName:
+			return resolveName(ctx, path[1:], req, keeper)
 		case GetTest:
 			return getTest(ctx, path[1:], req, keeper)
+		case GetParams:
+			return getParams(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown utxo query endpoint.")
 		}
@@ -42,9 +52,27 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 // nolint: unparam
 func listResources(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
-	records := keeper.ListResources(ctx)
+	// Optional query, to filter records by type, owner, attributes and an attribute filter,
+	// and to get a page of records.
+	var request ListRecordsRequest
+	if len(req.Data) > 0 {
+		if err2 := json.Unmarshal(req.Data, &request); err2 != nil {
+			return nil, sdk.ErrUnknownRequest("Invalid record query.")
+		}
+	}
+
+	if request.Filter != nil {
+		if err2 := request.Filter.Validate(); err2 != nil {
+			return nil, sdk.ErrUnknownRequest("Invalid record filter: " + err2.Error())
+		}
+	}
 
-	bz, err2 := json.MarshalIndent(records, "", "  ")
+	page, err2 := keeper.QueryResources(ctx, request.RecordQuery, request.PageRequest.WithDefaultPageSize())
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest("Invalid page request: " + err2.Error())
+	}
+
+	bz, err2 := json.MarshalIndent(page, "", "  ")
 	if err2 != nil {
 		panic("Could not marshal result to JSON.")
 	}
@@ -55,13 +83,94 @@ func listResources(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 // nolint: unparam
 func getResource(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
 
-	id := ID(strings.Join(path, "/"))
+	idStr := strings.Join(path, "/")
+
+	// A specific revision is requested using the ID@version format.
+	if index := strings.LastIndex(idStr, VersionSeparator); index >= 0 {
+		return getRevision(ctx, ID(idStr[:index]), idStr[index+1:], keeper)
+	}
+
+	id := ID(idStr)
 	if !keeper.HasResource(ctx, id) {
 		return nil, sdk.ErrInternal("Record not found.")
 	}
 
 	record := keeper.GetResource(ctx, id)
 
+	bz, err2 := marshalRecordWithMetadata(record, keeper.GetRecordMetadata(ctx, id))
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
+	}
+
+	return bz, nil
+}
+
+// marshalRecordWithMetadata returns the record JSON, with the record metadata as an additional `metadata` field.
+func marshalRecordWithMetadata(record Record, metadata RecordMetadata) ([]byte, error) {
+	recordBz, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(recordBz, &fields); err != nil {
+		return nil, err
+	}
+
+	if fields["metadata"], err = json.Marshal(metadata); err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(fields, "", "  ")
+}
+
+func getRevision(ctx sdk.Context, id ID, versionStr string, keeper Keeper) (res []byte, err sdk.Error) {
+	version, err2 := strconv.ParseUint(versionStr, 10, 64)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest("Invalid record version.")
+	}
+
+	if !keeper.HasRevision(ctx, id, version) {
+		return nil, sdk.ErrInternal("Record revision not found.")
+	}
+
+	revision := keeper.GetRevision(ctx, id, version)
+
+	bz, err2 := json.MarshalIndent(revision, "", "  ")
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
+	}
+
+	return bz, nil
+}
+
+// nolint: unparam
+func getHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+
+	id := ID(strings.Join(path, "/"))
+	revisions := keeper.ListRevisions(ctx, id)
+	if len(revisions) == 0 {
+		return nil, sdk.ErrInternal("Record not found.")
+	}
+
+	bz, err2 := json.MarshalIndent(revisions, "", "  ")
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
+	}
+
+	return bz, nil
+}
+
+// nolint: unparam
+func resolveName(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+
+	// Names contain slashes (e.g. wrn://wireline/bots/echo), so they are split across the path.
+	name := strings.Join(path, "/")
+	record, found := keeper.ResolveName(ctx, name)
+	if !found {
+		return nil, sdk.ErrInternal("Name not found.")
+	}
+
 	bz, err2 := json.MarshalIndent(record, "", "  ")
 	if err2 != nil {
 		panic("Could not marshal result to JSON.")
@@ -75,38 +184,29 @@ func getGraph(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keep
 	g := dot.NewGraph(dot.Directed)
 	g.Attr("rankdir", "LR")
 
+	var records []Record
 	if len(path) == 0 {
-		records := keeper.ListResources(ctx)
-		for _, r := range records {
-			GraphResourceNode(g, r)
-		}
+		records = keeper.ListResources(ctx)
 	} else {
-		pending := stack.New()
-		done := make(map[string]bool)
-		id := strings.Join(path, "/")
-		pending.Push(id)
-
-		for pending.Len() > 0 {
-			id := pending.Pop().(string)
-
-			if _, exists := done[id]; !exists {
-				r := keeper.GetResource(ctx, ID(id))
-				GraphResourceNode(g, r)
-
-				// for _, link := range r.Links {
-				// 	if idAttr, ok := link["id"].(string); ok {
-				// 		pending.Push(idAttr)
-				// 	}
+		// Records linked to (directly or indirectly) from the given record.
+		records = keeper.GetRecordGraph(ctx, ID(strings.Join(path, "/")), 0)
+	}
 
-				// }
-			}
+	for _, r := range records {
+		GraphResourceNode(g, r)
+	}
 
-			done[id] = true
-		}
+	return []byte(g.String()), nil
+}
 
+// nolint: unparam
+func getParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
 	}
 
-	return []byte(g.String()), nil
+	return bz, nil
 }
 
 // nolint: unparam
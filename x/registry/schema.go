@@ -0,0 +1,269 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeDefinitionType is the record type of registry type definitions.
+// The ID of a type definition record is the type it defines (e.g. wrn:registry-type:bot),
+// and its attributes hold the schema (`fields` and `strict`) that records of that type must satisfy.
+const TypeDefinitionType = "wrn:registry-type:type"
+
+// TypeDefinitionPrefix is the prefix of the IDs of type definitions (i.e. of the types that can have a schema).
+// IDs with this prefix are reserved for type definitions, so that other records can't take the ID of a type.
+const TypeDefinitionPrefix = "wrn:registry-type:"
+
+// Supported schema field types.
+const (
+	FieldTypeAny     = "any"
+	FieldTypeString  = "string"
+	FieldTypeNumber  = "number"
+	FieldTypeInteger = "integer"
+	FieldTypeBoolean = "boolean"
+	FieldTypeObject  = "object"
+	FieldTypeArray   = "array"
+	FieldTypeBytes   = "bytes"
+)
+
+var fieldTypes = map[string]bool{
+	FieldTypeAny:     true,
+	FieldTypeString:  true,
+	FieldTypeNumber:  true,
+	FieldTypeInteger: true,
+	FieldTypeBoolean: true,
+	FieldTypeObject:  true,
+	FieldTypeArray:   true,
+	FieldTypeBytes:   true,
+}
+
+// FieldSpec describes a single record attribute.
+type FieldSpec struct {
+	Type     string
+	Required bool
+}
+
+// Schema describes the attributes of records of a given type.
+type Schema struct {
+	Fields map[string]FieldSpec
+	// Strict schemas reject attributes that aren't declared in Fields.
+	Strict bool
+}
+
+// ParseSchema parses a schema from the attributes of a type definition record.
+func ParseSchema(attributes map[string]interface{}) (Schema, error) {
+	schema := Schema{Fields: make(map[string]FieldSpec)}
+
+	if strict, ok := attributes["strict"]; ok {
+		strictVal, ok := strict.(bool)
+		if !ok {
+			return schema, errors.New("schema 'strict' must be a boolean")
+		}
+
+		schema.Strict = strictVal
+	}
+
+	fields, ok := attributes["fields"]
+	if !ok {
+		return schema, nil
+	}
+
+	fieldsMap, ok := fields.(map[string]interface{})
+	if !ok {
+		return schema, errors.New("schema 'fields' must be a map")
+	}
+
+	for name, spec := range fieldsMap {
+		specMap, ok := spec.(map[string]interface{})
+		if !ok {
+			return schema, fmt.Errorf("schema field '%s' must be a map", name)
+		}
+
+		fieldType, ok := specMap["type"].(string)
+		if !ok || !fieldTypes[fieldType] {
+			return schema, fmt.Errorf("schema field '%s' has an invalid type", name)
+		}
+
+		var required bool
+		if val, ok := specMap["required"]; ok {
+			if required, ok = val.(bool); !ok {
+				return schema, fmt.Errorf("schema field '%s' 'required' must be a boolean", name)
+			}
+		}
+
+		schema.Fields[name] = FieldSpec{Type: fieldType, Required: required}
+	}
+
+	return schema, nil
+}
+
+// validateTypeDefinition checks that only type definitions have IDs with the type definition prefix, and that type
+// definitions have a valid schema and don't expire (records of their type would no longer be validated).
+func validateTypeDefinition(record RecordObj) error {
+	isTypeID := strings.HasPrefix(string(record.ID), TypeDefinitionPrefix)
+
+	if record.Type != TypeDefinitionType {
+		if isTypeID {
+			return fmt.Errorf("IDs starting with '%s' are reserved for type definitions", TypeDefinitionPrefix)
+		}
+
+		return nil
+	}
+
+	if !isTypeID || record.ID == TypeDefinitionType {
+		return fmt.Errorf("type definition IDs must start with '%s' (and can't be '%s')", TypeDefinitionPrefix, TypeDefinitionType)
+	}
+
+	if record.TTL != 0 {
+		return errors.New("type definitions can't have a TTL")
+	}
+
+	attributes, err := UnMarshalAttributes(record.Attributes)
+	if err != nil {
+		return err
+	}
+
+	_, err = ParseSchema(attributes)
+
+	return err
+}
+
+// CheckCompatible checks that records that are valid for the previous schema are also valid for this schema,
+// i.e. that fields keep their type (or become less specific), no field becomes required, and a schema doesn't
+// become strict or, if strict, lose fields. New fields must be optional, and of any type unless the previous schema
+// was strict (so that records don't already have them).
+func (schema Schema) CheckCompatible(previous Schema) error {
+	if schema.Strict && !previous.Strict {
+		return errors.New("schema can't be made strict")
+	}
+
+	names := make([]string, 0, len(schema.Fields)+len(previous.Fields))
+	for name := range previous.Fields {
+		names = append(names, name)
+	}
+	for name := range schema.Fields {
+		if _, ok := previous.Fields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		spec, ok := schema.Fields[name]
+		previousSpec, existed := previous.Fields[name]
+
+		switch {
+		case !ok:
+			if schema.Strict {
+				return fmt.Errorf("field '%s' can't be removed from a strict schema", name)
+			}
+		case !existed:
+			if spec.Required {
+				return fmt.Errorf("new field '%s' can't be required", name)
+			}
+
+			if !previous.Strict && spec.Type != FieldTypeAny {
+				return fmt.Errorf("new field '%s' must be of type '%s'", name, FieldTypeAny)
+			}
+		default:
+			if spec.Required && !previousSpec.Required {
+				return fmt.Errorf("field '%s' can't be made required", name)
+			}
+
+			if !isFieldTypeCompatible(spec.Type, previousSpec.Type) {
+				return fmt.Errorf("field '%s' type can't be changed from '%s' to '%s'", name, previousSpec.Type, spec.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isFieldTypeCompatible checks if all values of the previous field type match the field type.
+func isFieldTypeCompatible(fieldType string, previous string) bool {
+	return fieldType == previous || fieldType == FieldTypeAny ||
+		(fieldType == FieldTypeNumber && previous == FieldTypeInteger)
+}
+
+// Validate checks record attributes against the schema.
+func (schema Schema) Validate(attributes map[string]interface{}) error {
+	// Check fields in a stable order, so that the same error is always reported.
+	names := make([]string, 0, len(schema.Fields))
+	for name := range schema.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		spec := schema.Fields[name]
+
+		value, ok := attributes[name]
+		if !ok || value == nil {
+			if spec.Required {
+				return fmt.Errorf("missing required attribute '%s'", name)
+			}
+
+			continue
+		}
+
+		if !matchesFieldType(spec.Type, value) {
+			return fmt.Errorf("attribute '%s' must be of type '%s'", name, spec.Type)
+		}
+	}
+
+	if schema.Strict {
+		attributeNames := make([]string, 0, len(attributes))
+		for name := range attributes {
+			attributeNames = append(attributeNames, name)
+		}
+		sort.Strings(attributeNames)
+
+		for _, name := range attributeNames {
+			if _, ok := schema.Fields[name]; !ok {
+				return fmt.Errorf("unknown attribute '%s'", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Values are typed attribute values (see UnMarshalAttributes), so integers are int64 and other numbers float64.
+func matchesFieldType(fieldType string, value interface{}) bool {
+	switch fieldType {
+	case FieldTypeAny:
+		return true
+	case FieldTypeString:
+		_, ok := value.(string)
+		return ok
+	case FieldTypeNumber:
+		switch value.(type) {
+		case int64, float64:
+			return true
+		}
+		return false
+	case FieldTypeInteger:
+		_, ok := value.(int64)
+		return ok
+	case FieldTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case FieldTypeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case FieldTypeArray:
+		_, ok := value.([]interface{})
+		return ok
+	case FieldTypeBytes:
+		_, ok := value.([]byte)
+		return ok
+	}
+
+	return false
+}
@@ -11,6 +11,15 @@ import (
 
 // GetResourceSignature returns a cryptographic signature for a transaction.
 func GetResourceSignature(record Record, name string) ([]byte, crypto.PubKey, error) {
+	return getSignature(GenRecordHash(record), name)
+}
+
+// GetTransferSignature returns a cryptographic signature for a record transfer.
+func GetTransferSignature(transfer Transfer, name string) ([]byte, crypto.PubKey, error) {
+	return getSignature(GenTransferHash(transfer), name)
+}
+
+func getSignature(signBytes []byte, name string) ([]byte, crypto.PubKey, error) {
 	keybase, err := keys.GetKeyBase()
 	if err != nil {
 		return nil, nil, err
@@ -21,8 +30,6 @@ func GetResourceSignature(record Record, name string) ([]byte, crypto.PubKey, er
 		return nil, nil, err
 	}
 
-	signBytes := GenRecordHash(record)
-
 	sigBytes, pubKey, err := keybase.Sign(name, passphrase, signBytes)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,90 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package registry
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tag keys added to the results of registry txs, used to index and query txs (e.g. `regcli query txs --tags`).
+const (
+	TagAction    = "action" // Same as sdk.TagAction.
+	TagRecordID  = "record-id"
+	TagType      = "record-type"
+	TagOwner     = "owner"
+	TagSigner    = "signer"
+	TagName      = "name"
+	TagNameOwner = "name-owner"
+
+	// The ID of a record with a link to a deleted (or expired) record, which is left dangling.
+	TagDanglingLink = "dangling-link"
+)
+
+// TagExpiredRecord is the tag added to the end block response for each pruned record.
+const TagExpiredRecord = "expired-record"
+
+// Values of the action tag.
+const (
+	ActionSetRecord      = "set-record"
+	ActionRenewRecord    = "renew-record"
+	ActionDeleteRecord   = "delete-record"
+	ActionOfferTransfer  = "offer-record-transfer"
+	ActionTransferRecord = "transfer-record"
+	ActionClearRecords   = "clear-records"
+	ActionReserveName    = "reserve-name"
+	ActionSetName        = "set-name"
+	ActionTransferName   = "transfer-name"
+	ActionReleaseName    = "release-name"
+)
+
+// getRecordTags returns the tags for an action on a record: the action, record ID, type, and a tag for each owner.
+func getRecordTags(action string, record Record, signer sdk.AccAddress) sdk.Tags {
+	tags := sdk.NewTags(
+		TagAction, []byte(action),
+		TagRecordID, []byte(record.ID),
+		TagType, []byte(record.Type),
+		TagSigner, []byte(signer.String()),
+	)
+
+	for _, owner := range record.GetOwners() {
+		tags = tags.AppendTag(TagOwner, []byte(owner))
+	}
+
+	return tags
+}
+
+// getDanglingLinkTags returns a tag for each record that links to a deleted (or expired) record.
+// Deletes and expiry don't check links to the record, so these are left dangling.
+func getDanglingLinkTags(ctx sdk.Context, keeper Keeper, id ID) sdk.Tags {
+	tags := sdk.EmptyTags()
+
+	for _, linkingID := range keeper.GetLinkingIDs(ctx, id) {
+		if linkingID != id {
+			tags = tags.AppendTag(TagDanglingLink, []byte(linkingID))
+		}
+	}
+
+	return tags
+}
+
+// getTransferTags returns the tags for a record transfer, tagging both the current and the new owners.
+func getTransferTags(action string, record Record, newOwners []string, signer sdk.AccAddress) sdk.Tags {
+	tags := getRecordTags(action, record, signer)
+
+	for _, owner := range newOwners {
+		tags = tags.AppendTag(TagOwner, []byte(owner))
+	}
+
+	return tags
+}
+
+// getNameTags returns the tags for an action on a name.
+func getNameTags(action string, name string, signer sdk.AccAddress) sdk.Tags {
+	return sdk.NewTags(
+		TagAction, []byte(action),
+		TagName, []byte(name),
+		TagSigner, []byte(signer.String()),
+	)
+}
@@ -6,11 +6,20 @@ package registry
 
 import (
 	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // WirelineChainID is the Cosmos SDK chain ID.
 const WirelineChainID = "wireline"
 
+// VersionSeparator separates a record ID from a revision number (e.g. wrn:record:xxxx@3).
+const VersionSeparator = "@"
+
+// NamePrefix is the prefix of names in the naming service (e.g. wrn://wireline/bots/echo).
+const NamePrefix = "wrn://"
+
 // ID for records.
 type ID string
 
@@ -19,9 +28,181 @@ type Record struct {
 	ID    ID     `json:"id"`
 	Type  string `json:"type"`
 	Owner string `json:"owner"`
-	// SystemAttributes map[string]interface{}   `json:"systemAttributes"`
+	// Version of the record, which must be incremented by each write (starting at 1), so that
+	// signed payloads can't be replayed.
+	Version uint64 `json:"version"`
+	// Additional owners, for records shared by a group of owners.
+	Owners []string `json:"owners,omitempty"`
+	// Number of owner signatures required to write the record (defaults to 1).
+	Threshold int `json:"threshold,omitempty"`
+	// Number of blocks to keep the record for from this write, with the rent paid by the owner (0 to keep the
+	// expiry of an existing record, or to keep a new record indefinitely). It's signed, like the rest of the record,
+	// so that only the owners can set when it expires.
+	TTL        int64                  `json:"ttl,omitempty"`
 	Attributes map[string]interface{} `json:"attributes"`
-	// Links            []map[string]interface{} `json:"links"`
+	Links      []Link                 `json:"links,omitempty"`
+}
+
+// recordJSON is used to (un)marshal records, with attributes in their typed encoding.
+type recordJSON struct {
+	ID         ID              `json:"id"`
+	Type       string          `json:"type"`
+	Owner      string          `json:"owner"`
+	Version    uint64          `json:"version"`
+	Owners     []string        `json:"owners,omitempty"`
+	Threshold  int             `json:"threshold,omitempty"`
+	TTL        int64           `json:"ttl,omitempty"`
+	Attributes json.RawMessage `json:"attributes"`
+	Links      []Link          `json:"links,omitempty"`
+}
+
+// MarshalJSON encodes the record, preserving attribute value types (see MarshalAttributes).
+func (record Record) MarshalJSON() ([]byte, error) {
+	attributes, err := MarshalAttributes(record.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(recordJSON{
+		ID:         record.ID,
+		Type:       record.Type,
+		Owner:      record.Owner,
+		Version:    record.Version,
+		Owners:     record.Owners,
+		Threshold:  record.Threshold,
+		TTL:        record.TTL,
+		Attributes: attributes,
+		Links:      record.Links,
+	})
+}
+
+// UnmarshalJSON decodes the record, preserving attribute value types (see UnMarshalAttributes).
+func (record *Record) UnmarshalJSON(bz []byte) error {
+	var val recordJSON
+	if err := json.Unmarshal(bz, &val); err != nil {
+		return err
+	}
+
+	var attributes map[string]interface{}
+	if len(val.Attributes) > 0 {
+		var err error
+		if attributes, err = UnMarshalAttributes(val.Attributes); err != nil {
+			return err
+		}
+	}
+
+	*record = Record{
+		ID:         val.ID,
+		Type:       val.Type,
+		Owner:      val.Owner,
+		Version:    val.Version,
+		Owners:     val.Owners,
+		Threshold:  val.Threshold,
+		TTL:        val.TTL,
+		Attributes: attributes,
+		Links:      val.Links,
+	}
+
+	return nil
+}
+
+// GetOwners returns the (distinct) owners of the record, starting with Owner.
+func (record Record) GetOwners() []string {
+	owners := []string{record.Owner}
+	seen := map[string]bool{record.Owner: true}
+
+	for _, owner := range record.Owners {
+		if !seen[owner] {
+			owners = append(owners, owner)
+			seen[owner] = true
+		}
+	}
+
+	return owners
+}
+
+// GetThreshold returns the number of owner signatures required to write the record.
+func (record Record) GetThreshold() int {
+	if record.Threshold <= 0 {
+		return 1
+	}
+
+	return record.Threshold
+}
+
+// Link represents a typed link from a record to another record.
+type Link struct {
+	ID    ID     `json:"id"`
+	Label string `json:"label,omitempty"`
+}
+
+// RecordMetadata represents system-maintained information about a record.
+type RecordMetadata struct {
+	// Block height, block time and tx hash of the write that created the record.
+	CreateHeight int64     `json:"createHeight"`
+	CreateTime   time.Time `json:"createTime"`
+	CreateTxHash string    `json:"createTxHash"`
+
+	// Block height, block time and tx hash of the latest write.
+	UpdateHeight int64     `json:"updateHeight"`
+	UpdateTime   time.Time `json:"updateTime"`
+	TxHash       string    `json:"txHash"`
+
+	// Block height after which the record is pruned (0 if it never expires).
+	ExpiryHeight int64 `json:"expiryHeight"`
+
+	// Whether the latest write linked to records that didn't exist (see MsgSetRecord.AllowDanglingLinks).
+	DanglingLinks bool `json:"danglingLinks,omitempty"`
+
+	// Fee paid for the latest write, the part of it held in escrow (refunded on delete), and the account that paid it.
+	Fee       sdk.Coins      `json:"fee,omitempty"`
+	FeeEscrow sdk.Coins      `json:"feeEscrow,omitempty"`
+	FeePayer  sdk.AccAddress `json:"feePayer,omitempty"`
+
+	// Transfer offered by the record owners, waiting to be accepted by the new owners.
+	PendingTransfer *Transfer `json:"pendingTransfer,omitempty"`
+
+	// Completed ownership transfers, oldest first.
+	Transfers []OwnershipTransfer `json:"transfers,omitempty"`
+}
+
+// Transfer represents a change of record ownership, to a new owner or group of owners.
+// Version is the current version of the record, so that signed transfers can't be replayed.
+type Transfer struct {
+	ID        ID       `json:"id"`
+	Version   uint64   `json:"version"`
+	Owner     string   `json:"owner"`
+	Owners    []string `json:"owners,omitempty"`
+	Threshold int      `json:"threshold,omitempty"`
+}
+
+// TransferPayload represents a signed transfer payload that can be serialized from/to YAML.
+type TransferPayload struct {
+	Transfer   Transfer    `json:"transfer"`
+	Signatures []Signature `json:"signatures"`
+}
+
+// OwnershipTransfer records a completed transfer of a record.
+type OwnershipTransfer struct {
+	Height int64    `json:"height"`
+	TxHash string   `json:"txHash"`
+	From   []string `json:"from"`
+	To     []string `json:"to"`
+}
+
+// NameRecord represents a name reserved in the naming service, optionally pointing at a record.
+type NameRecord struct {
+	Owner sdk.AccAddress `json:"owner"`
+	ID    ID             `json:"id"`
+}
+
+// RecordQuery represents a query for records by type, owner, attribute values and an attribute filter.
+// Empty fields are not used for matching.
+type RecordQuery struct {
+	Type       string                 `json:"type,omitempty"`
+	Owner      string                 `json:"owner,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Filter     *Filter                `json:"filter,omitempty"`
 }
 
 // Signature represents a record signature.
@@ -38,12 +219,31 @@ type PayloadObj struct {
 
 // RecordObj represents a registry record.
 type RecordObj struct {
-	ID    ID     `json:"id"`
-	Type  string `json:"type"`
-	Owner string `json:"owner"`
-	// SystemAttributes []byte `json:"systemAttributes"`
-	Attributes []byte `json:"attributes"`
-	// Links            []byte `json:"links"`
+	ID         ID       `json:"id"`
+	Type       string   `json:"type"`
+	Owner      string   `json:"owner"`
+	Version    uint64   `json:"version"`
+	Owners     []string `json:"owners,omitempty"`
+	Threshold  int      `json:"threshold,omitempty"`
+	TTL        int64    `json:"ttl,omitempty"`
+	Attributes []byte   `json:"attributes"`
+	Links      []Link   `json:"links,omitempty"`
+}
+
+// RevisionObj represents an immutable, numbered revision of a record.
+type RevisionObj struct {
+	Version uint64    `json:"version"`
+	Height  int64     `json:"height"`
+	TxHash  string    `json:"txHash"`
+	Record  RecordObj `json:"record"`
+}
+
+// Revision represents an immutable, numbered revision of a record that can be serialized from/to YAML.
+type Revision struct {
+	Version uint64 `json:"version"`
+	Height  int64  `json:"height"`
+	TxHash  string `json:"txHash"`
+	Record  Record `json:"record"`
 }
 
 // Payload represents a signed record payload that can be serialized from/to YAML.
@@ -60,36 +260,16 @@ func RecordToRecordObj(record Record) RecordObj {
 	resourceObj.ID = record.ID
 	resourceObj.Type = record.Type
 	resourceObj.Owner = record.Owner
-	// resourceObj.SystemAttributes = MarshalMapToJSONBytes(record.SystemAttributes)
+	resourceObj.Version = record.Version
+	resourceObj.Owners = record.Owners
+	resourceObj.Threshold = record.Threshold
+	resourceObj.TTL = record.TTL
 	resourceObj.Attributes = MarshalMapToJSONBytes(record.Attributes)
-
-	// resourceObj.Links = MarshalLinksToJSONBytes(record.Links)
+	resourceObj.Links = record.Links
 
 	return resourceObj
 }
 
-// MarshalLinksToJSONBytes converts []map[string]interface{} to bytes.
-func MarshalLinksToJSONBytes(val []map[string]interface{}) (bytes []byte) {
-	bytes, err := json.Marshal(val)
-	if err != nil {
-		panic("Marshal error.")
-	}
-
-	return
-}
-
-// UnMarshalLinksFromJSONBytes converts bytes to []map[string]interface{}.
-func UnMarshalLinksFromJSONBytes(bytes []byte) []map[string]interface{} {
-	var val []map[string]interface{}
-	err := json.Unmarshal(bytes, &val)
-
-	if err != nil {
-		panic("Marshal error.")
-	}
-
-	return val
-}
-
 // PayloadToPayloadObj converts Payload to PayloadObj object.
 // Why? Because go-amino can't handle maps: https://github.com/tendermint/go-amino/issues/4.
 func PayloadToPayloadObj(payload Payload) PayloadObj {
@@ -101,9 +281,9 @@ func PayloadToPayloadObj(payload Payload) PayloadObj {
 	return payloadObj
 }
 
-// MarshalMapToJSONBytes converts map[string]interface{} to bytes.
+// MarshalMapToJSONBytes converts map[string]interface{} to bytes (see MarshalAttributes).
 func MarshalMapToJSONBytes(val map[string]interface{}) (bytes []byte) {
-	bytes, err := json.Marshal(val)
+	bytes, err := MarshalAttributes(val)
 	if err != nil {
 		panic("Marshal error.")
 	}
@@ -121,10 +301,9 @@ func MarshalSliceToJSONBytes(val []interface{}) (bytes []byte) {
 	return
 }
 
-// UnMarshalMapFromJSONBytes converts bytes to map[string]interface{}.
+// UnMarshalMapFromJSONBytes converts bytes to map[string]interface{} (see UnMarshalAttributes).
 func UnMarshalMapFromJSONBytes(bytes []byte) map[string]interface{} {
-	var val map[string]interface{}
-	err := json.Unmarshal(bytes, &val)
+	val, err := UnMarshalAttributes(bytes)
 
 	if err != nil {
 		panic("Marshal error.")
@@ -153,9 +332,12 @@ func RecordObjToRecord(resourceObj RecordObj) Record {
 	record.ID = resourceObj.ID
 	record.Type = resourceObj.Type
 	record.Owner = resourceObj.Owner
-	// record.SystemAttributes = UnMarshalMapFromJSONBytes(resourceObj.SystemAttributes)
+	record.Version = resourceObj.Version
+	record.Owners = resourceObj.Owners
+	record.Threshold = resourceObj.Threshold
+	record.TTL = resourceObj.TTL
 	record.Attributes = UnMarshalMapFromJSONBytes(resourceObj.Attributes)
-	// record.Links = UnMarshalLinksFromJSONBytes(resourceObj.Links)
+	record.Links = resourceObj.Links
 
 	return record
 }
@@ -170,3 +352,16 @@ func PayloadObjToPayload(payloadObj PayloadObj) Payload {
 
 	return payload
 }
+
+// RevisionObjToRevision converts RevisionObj to Revision.
+// Why? Because go-amino can't handle maps: https://github.com/tendermint/go-amino/issues/4.
+func RevisionObjToRevision(revisionObj RevisionObj) Revision {
+	var revision Revision
+
+	revision.Version = revisionObj.Version
+	revision.Height = revisionObj.Height
+	revision.TxHash = revisionObj.TxHash
+	revision.Record = RecordObjToRecord(revisionObj.Record)
+
+	return revision
+}
@@ -36,6 +36,7 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		utxocmd.GetCmdGetTx("utxo", mc.cdc),
 		utxocmd.GetCmdGetBalance("utxo", mc.cdc),
 		utxocmd.GetCmdGraph("utxo", mc.cdc),
+		utxocmd.GetCmdParams("utxo", mc.cdc),
 	)...)
 
 	return utxoQueryCmd
@@ -0,0 +1,176 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package utxo
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState represents the UTXO state at the start of the chain.
+type GenesisState struct {
+	Params     Params      `json:"params"`
+	AccOutputs []AccOutput `json:"acc_outputs"`
+	Txs        []GenesisTx `json:"txs"`
+	OutPoints  []OutPoint  `json:"outpoints"`
+}
+
+// GenesisTx represents a transaction, with its hash.
+type GenesisTx struct {
+	Hash Hash `json:"hash"`
+	Tx   Tx   `json:"tx"`
+}
+
+// DefaultGenesisState returns a UTXO genesis state with the default params, and no outputs.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:     DefaultParams(),
+		AccOutputs: []AccOutput{},
+		Txs:        []GenesisTx{},
+		OutPoints:  []OutPoint{},
+	}
+}
+
+// InitGenesis imports the UTXO state from genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, accOutput := range data.AccOutputs {
+		keeper.PutAccOutput(ctx, accOutput)
+	}
+
+	for _, tx := range data.Txs {
+		keeper.PutTx(ctx, tx.Hash, tx.Tx)
+	}
+
+	for _, outpoint := range data.OutPoints {
+		keeper.PutOutPoint(ctx, outpoint)
+	}
+}
+
+// ExportGenesis exports the UTXO state, in store key order.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	data := DefaultGenesisState()
+	data.Params = keeper.GetParams(ctx)
+
+	data.AccOutputs = append(data.AccOutputs, keeper.ListAccOutput(ctx)...)
+
+	txs, hashes := keeper.ListTx(ctx)
+	for index, tx := range txs {
+		data.Txs = append(data.Txs, GenesisTx{Hash: hashes[index], Tx: tx})
+	}
+
+	data.OutPoints = append(data.OutPoints, keeper.ListUtxo(ctx)...)
+
+	return data
+}
+
+// ValidateGenesis checks that the UTXO genesis state is consistent, i.e. there are no duplicates,
+// and each unspent outpoint refers to an account output or a transaction output.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %s", err)
+	}
+
+	accOutputs := make(map[string]AccOutput)
+	for _, accOutput := range data.AccOutputs {
+		if len(accOutput.ID) == 0 {
+			return fmt.Errorf("account output has no ID")
+		}
+
+		if _, exists := accOutputs[accOutput.ID.String()]; exists {
+			return fmt.Errorf("duplicate account output %s", accOutput.ID)
+		}
+
+		if accOutput.Address.Empty() {
+			return fmt.Errorf("account output %s has no address", accOutput.ID)
+		}
+
+		accOutputs[accOutput.ID.String()] = accOutput
+	}
+
+	txs := make(map[string]Tx)
+	for _, tx := range data.Txs {
+		if len(tx.Hash) == 0 {
+			return fmt.Errorf("transaction has no hash")
+		}
+
+		if _, exists := txs[tx.Hash.String()]; exists {
+			return fmt.Errorf("duplicate transaction %s", tx.Hash)
+		}
+
+		txs[tx.Hash.String()] = tx.Tx
+	}
+
+	outpoints := make(map[string]bool)
+	for _, outpoint := range data.OutPoints {
+		if _, err := getOutPointValue(outpoint, accOutputs, txs); err != nil {
+			return err
+		}
+
+		key := GetOutPointKey(outpoint)
+		if outpoints[key] {
+			return fmt.Errorf("duplicate outpoint %s", key)
+		}
+		outpoints[key] = true
+	}
+
+	return nil
+}
+
+// GetEscrowedCoins returns the coins held in unspent outputs in the genesis state.
+func (data GenesisState) GetEscrowedCoins() (sdk.Coins, error) {
+	accOutputs := make(map[string]AccOutput)
+	for _, accOutput := range data.AccOutputs {
+		accOutputs[accOutput.ID.String()] = accOutput
+	}
+
+	txs := make(map[string]Tx)
+	for _, tx := range data.Txs {
+		txs[tx.Hash.String()] = tx.Tx
+	}
+
+	total := new(big.Int)
+	for _, outpoint := range data.OutPoints {
+		value, err := getOutPointValue(outpoint, accOutputs, txs)
+		if err != nil {
+			return nil, err
+		}
+
+		total.Add(total, new(big.Int).SetUint64(value))
+	}
+
+	if total.Sign() == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	return sdk.Coins{sdk.NewCoin(Denom, sdk.NewIntFromBigInt(total))}, nil
+}
+
+func getOutPointValue(outpoint OutPoint, accOutputs map[string]AccOutput, txs map[string]Tx) (uint64, error) {
+	key := GetOutPointKey(outpoint)
+
+	if outpoint.Index == OutPointAccountBirth {
+		accOutput, exists := accOutputs[outpoint.Hash.String()]
+		if !exists {
+			return 0, fmt.Errorf("outpoint %s refers to a missing account output", key)
+		}
+
+		return accOutput.Value, nil
+	}
+
+	if outpoint.Index < 0 {
+		return 0, fmt.Errorf("outpoint %s has an unsupported index", key)
+	}
+
+	tx, exists := txs[outpoint.Hash.String()]
+	if !exists || int(outpoint.Index) >= len(tx.TxOut) {
+		return 0, fmt.Errorf("outpoint %s refers to a missing transaction output", key)
+	}
+
+	return tx.TxOut[outpoint.Index].Value, nil
+}
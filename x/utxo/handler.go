@@ -39,13 +39,21 @@ func handleMsgBirthAccOutput(ctx sdk.Context, keeper Keeper, msg MsgBirthAccOutp
 		return sdk.ErrInternal("Error generating account UTXO.").Result()
 	}
 
-	keeper.PutAccOutput(ctx, accUtxo)
-	keeper.PutOutPoint(ctx, OutPoint{
+	outpoint := OutPoint{
 		Hash:  accUtxo.ID,
 		Index: OutPointAccountBirth,
-	})
+	}
+
+	keeper.PutAccOutput(ctx, accUtxo)
+	keeper.PutOutPoint(ctx, outpoint)
 
-	return sdk.Result{}
+	tags := sdk.NewTags(
+		TagAction, []byte(ActionBirthAccOutput),
+		TagAddress, []byte(msg.Address.String()),
+		TagCreatedOutPoint, []byte(GetOutPointKey(outpoint)),
+	)
+
+	return sdk.Result{Tags: tags}
 }
 
 // Handle MsgTx.
@@ -60,6 +68,10 @@ func handleMsgTx(ctx sdk.Context, keeper Keeper, msg MsgTx) sdk.Result {
 		return sdk.ErrInternal("Multiple inputs not yet supported.").Result()
 	}
 
+	if maxOutputs := keeper.GetParams(ctx).MaxTxOutputs; maxOutputs > 0 && int64(len(msg.Tx.TxOut)) > maxOutputs {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Tx outputs exceed the max of %d.", maxOutputs)).Result()
+	}
+
 	input := msg.Tx.TxIn[0].Input
 	witness := msg.Tx.TxIn[0].Witness
 
@@ -107,13 +119,28 @@ func handleMsgTx(ctx sdk.Context, keeper Keeper, msg MsgTx) sdk.Result {
 	// Delete old UTXO.
 	keeper.DeleteOutPoint(ctx, input)
 
+	tags := sdk.NewTags(
+		TagAction, []byte(ActionTx),
+		TagTxHash, []byte(Hash(txHash).String()),
+		TagAddress, []byte(redeemAddress.String()),
+		TagSpentOutPoint, []byte(GetOutPointKey(input)),
+	)
+
 	// Create new UTXOs.
-	for index := range msg.Tx.TxOut {
-		keeper.PutOutPoint(ctx, OutPoint{
+	for index, txOut := range msg.Tx.TxOut {
+		outpoint := OutPoint{
 			Hash:  txHash,
 			Index: int32(index),
-		})
+		}
+
+		keeper.PutOutPoint(ctx, outpoint)
+		tags = tags.AppendTag(TagCreatedOutPoint, []byte(GetOutPointKey(outpoint)))
+
+		var obj PayToAddress
+		if err := keeper.cdc.UnmarshalBinaryBare(txOut.PkScript, &obj); err == nil && !obj.Address.Empty() {
+			tags = tags.AppendTag(TagAddress, []byte(obj.Address.String()))
+		}
 	}
 
-	return sdk.Result{}
+	return sdk.Result{Tags: tags}
 }
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine.
@@ -19,18 +20,22 @@ type Keeper struct {
 	coinKeeper      bank.Keeper
 	accUtxoStoreKey sdk.StoreKey // Unexposed key to access Account UTXO store from sdk.Context.
 	utxoStoreKey    sdk.StoreKey // Unexposed key to access UTXO store from sdk.Context.
+	addressStoreKey sdk.StoreKey // Unexposed key to access UTXO address index from sdk.Context.
 	txStoreKey      sdk.StoreKey // Unexposed key to access TX store from sdk.Context.
+	paramstore      params.Subspace
 	cdc             *codec.Codec // The wire codec for binary encoding/decoding.
 }
 
 // NewKeeper creates new instances of the UTXO Keeper.
-func NewKeeper(accountKeeper auth.AccountKeeper, coinKeeper bank.Keeper, accUtxoStoreKey sdk.StoreKey, utxoStoreKey sdk.StoreKey, txStoreKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+func NewKeeper(accountKeeper auth.AccountKeeper, coinKeeper bank.Keeper, accUtxoStoreKey sdk.StoreKey, utxoStoreKey sdk.StoreKey, addressStoreKey sdk.StoreKey, txStoreKey sdk.StoreKey, paramstore params.Subspace, cdc *codec.Codec) Keeper {
 	return Keeper{
 		accountKeeper:   accountKeeper,
 		coinKeeper:      coinKeeper,
 		accUtxoStoreKey: accUtxoStoreKey,
 		utxoStoreKey:    utxoStoreKey,
+		addressStoreKey: addressStoreKey,
 		txStoreKey:      txStoreKey,
+		paramstore:      paramstore.WithTypeTable(ParamTypeTable()),
 		cdc:             cdc,
 	}
 }
@@ -82,10 +87,23 @@ func GetOutPointKey(op OutPoint) string {
 	return fmt.Sprintf("%s:%d", op.Hash, op.Index)
 }
 
-// PutOutPoint saves an outpoint to the UTXO store.
+// getAddressIndexKey returns the key of an outpoint in the address index, i.e. the address the output is
+// payable to (addresses have a fixed length), then the outpoint key, so that an address's outpoints are in
+// outpoint key order.
+func getAddressIndexKey(address sdk.AccAddress, outpointKey string) []byte {
+	return append(append([]byte{}, address...), []byte(outpointKey)...)
+}
+
+// PutOutPoint saves an outpoint to the UTXO store, and the address index.
+// The output it references (a transaction output, or an account output birth record) must already be saved.
 func (k Keeper) PutOutPoint(ctx sdk.Context, outpoint OutPoint) {
 	store := ctx.KVStore(k.utxoStoreKey)
 	store.Set([]byte(GetOutPointKey(outpoint)), k.cdc.MustMarshalBinaryBare(outpoint))
+
+	if address, _, found := k.GetOutPointOutput(ctx, outpoint); found && !address.Empty() {
+		addressStore := ctx.KVStore(k.addressStoreKey)
+		addressStore.Set(getAddressIndexKey(address, GetOutPointKey(outpoint)), k.cdc.MustMarshalBinaryBare(outpoint))
+	}
 }
 
 // HasOutPoint checks if the given outpoint exists in the UTXO list.
@@ -94,10 +112,15 @@ func (k Keeper) HasOutPoint(ctx sdk.Context, outpoint OutPoint) bool {
 	return store.Has([]byte(GetOutPointKey(outpoint)))
 }
 
-// DeleteOutPoint deletes the given outpoint from the UTXO list.
+// DeleteOutPoint deletes the given outpoint from the UTXO list, and the address index.
 func (k Keeper) DeleteOutPoint(ctx sdk.Context, outpoint OutPoint) {
 	store := ctx.KVStore(k.utxoStoreKey)
 	store.Delete([]byte(GetOutPointKey(outpoint)))
+
+	if address, _, found := k.GetOutPointOutput(ctx, outpoint); found && !address.Empty() {
+		addressStore := ctx.KVStore(k.addressStoreKey)
+		addressStore.Delete(getAddressIndexKey(address, GetOutPointKey(outpoint)))
+	}
 }
 
 // ListUtxo - get all account UTXO records.
@@ -119,6 +142,94 @@ func (k Keeper) ListUtxo(ctx sdk.Context) []OutPoint {
 	return records
 }
 
+// IterateUtxo - iterates over the UTXO list in outpoint key order, starting after the given outpoint key
+// (if not empty), until the handler returns true.
+func (k Keeper) IterateUtxo(ctx sdk.Context, after string, handler func(outpoint OutPoint) (stop bool)) {
+	k.iterateOutPoints(ctx.KVStore(k.utxoStoreKey), nil, after, handler)
+}
+
+// IterateAddressUtxo - iterates over the UTXOs payable to an address (using the address index) in outpoint key
+// order, starting after the given outpoint key (if not empty), until the handler returns true.
+func (k Keeper) IterateAddressUtxo(ctx sdk.Context, address sdk.AccAddress, after string, handler func(outpoint OutPoint) (stop bool)) {
+	k.iterateOutPoints(ctx.KVStore(k.addressStoreKey), address, after, handler)
+}
+
+// iterateOutPoints iterates over the outpoints under the key prefix, starting after prefix + after.
+func (k Keeper) iterateOutPoints(store sdk.KVStore, prefix []byte, after string, handler func(outpoint OutPoint) (stop bool)) {
+	start := append(append([]byte{}, prefix...), []byte(after)...)
+	if after != "" {
+		// The smallest key after prefix + after.
+		start = append(start, 0)
+	}
+
+	var end []byte
+	if len(prefix) > 0 {
+		end = sdk.PrefixEndBytes(prefix)
+	}
+
+	itr := store.Iterator(start, end)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var obj OutPoint
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &obj)
+		if handler(obj) {
+			return
+		}
+	}
+}
+
+// GetOutPointOutput - gets the address and value of the output referenced by an outpoint (a transaction output,
+// or an account output birth record).
+func (k Keeper) GetOutPointOutput(ctx sdk.Context, outpoint OutPoint) (address sdk.AccAddress, value uint64, found bool) {
+	if outpoint.Index >= 0 {
+		if !k.HasTx(ctx, outpoint.Hash) {
+			return nil, 0, false
+		}
+
+		tx := k.GetTx(ctx, outpoint.Hash)
+		if int(outpoint.Index) >= len(tx.TxOut) {
+			return nil, 0, false
+		}
+
+		txOut := tx.TxOut[outpoint.Index]
+
+		var obj PayToAddress
+		k.cdc.MustUnmarshalBinaryBare(txOut.PkScript, &obj)
+
+		return obj.Address, txOut.Value, true
+	}
+
+	if outpoint.Index == OutPointAccountBirth && k.HasAccOutput(ctx, outpoint.Hash) {
+		accOutput := k.GetAccOutput(ctx, outpoint.Hash)
+		return accOutput.Address, accOutput.Value, true
+	}
+
+	return nil, 0, false
+}
+
+// GetWallet - gets the balance and UTXOs of an address.
+func (k Keeper) GetWallet(ctx sdk.Context, address sdk.AccAddress) Wallet {
+	var wallet Wallet
+
+	// For each UTXO payable to the address (from the address index):
+	// Get the transaction output (or account output birth record).
+	// Add UTXO value to current balance.
+	k.IterateAddressUtxo(ctx, address, "", func(outpoint OutPoint) bool {
+		if _, value, found := k.GetOutPointOutput(ctx, outpoint); found {
+			wallet.Balance += value
+			wallet.Entries = append(wallet.Entries, OutPointVal{
+				Hash:  outpoint.Hash,
+				Index: outpoint.Index,
+				Value: value,
+			})
+		}
+
+		return false
+	})
+
+	return wallet
+}
+
 // HasTx checks if a transaction by the given hash exists.
 func (k Keeper) HasTx(ctx sdk.Context, hash Hash) bool {
 	store := ctx.KVStore(k.txStoreKey)
@@ -0,0 +1,233 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package utxo
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	testAddress1 = sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	testAddress2 = sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	testAddress3 = sdk.AccAddress(bytes.Repeat([]byte{3}, 20))
+)
+
+// setupTestKeeper returns a UTXO keeper backed by in-memory stores, and a context at height 1.
+func setupTestKeeper(t *testing.T) (sdk.Context, Keeper) {
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	keyAccount := sdk.NewKVStoreKey("acc")
+	keyParams := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	keyAccUtxo := sdk.NewKVStoreKey("acc_utxo")
+	keyUtxo := sdk.NewKVStoreKey("utxo")
+	keyUtxoAddress := sdk.NewKVStoreKey("utxo_address")
+	keyTx := sdk.NewKVStoreKey("tx")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range []sdk.StoreKey{keyAccount, keyParams, keyAccUtxo, keyUtxo, keyUtxoAddress, keyTx} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAccount, auth.ProtoBaseAccount)
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	keeper := NewKeeper(accountKeeper, bank.NewBaseKeeper(accountKeeper), keyAccUtxo, keyUtxo, keyUtxoAddress, keyTx,
+		paramsKeeper.Subspace(DefaultParamspace), cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+	keeper.SetParams(ctx, DefaultParams())
+
+	return ctx, keeper
+}
+
+// putTestOutputs saves an account output birth record for address 1, and a tx paying addresses 1, 2 and 1 again,
+// with their outpoints.
+func putTestOutputs(ctx sdk.Context, keeper Keeper) []OutPoint {
+	accOutput := AccOutput{ID: Hash(bytes.Repeat([]byte{0xaa}, 32)), Value: 100, Address: testAddress1, Block: 1}
+	keeper.PutAccOutput(ctx, accOutput)
+
+	tx := Tx{TxIn: []TxIn{{Input: OutPoint{Hash: accOutput.ID, Index: OutPointAccountBirth}}}}
+	for index, address := range []sdk.AccAddress{testAddress1, testAddress2, testAddress1} {
+		tx.TxOut = append(tx.TxOut, TxOut{
+			Value:    uint64(10 * (index + 1)),
+			PkScript: keeper.cdc.MustMarshalBinaryBare(PayToAddress{Address: address}),
+		})
+	}
+
+	txHash := Hash(bytes.Repeat([]byte{0x11}, 32))
+	keeper.PutTx(ctx, txHash, tx)
+
+	outpoints := []OutPoint{{Hash: accOutput.ID, Index: OutPointAccountBirth}}
+	for index := range tx.TxOut {
+		outpoints = append(outpoints, OutPoint{Hash: txHash, Index: int32(index)})
+	}
+
+	for _, outpoint := range outpoints {
+		keeper.PutOutPoint(ctx, outpoint)
+	}
+
+	return outpoints
+}
+
+func getOutPointKeys(outpoints []OutPoint) []string {
+	keys := []string{}
+	for _, outpoint := range outpoints {
+		keys = append(keys, GetOutPointKey(outpoint))
+	}
+
+	return keys
+}
+
+func collectAddressUtxo(ctx sdk.Context, keeper Keeper, address sdk.AccAddress, after string) []OutPoint {
+	var outpoints []OutPoint
+	keeper.IterateAddressUtxo(ctx, address, after, func(outpoint OutPoint) bool {
+		outpoints = append(outpoints, outpoint)
+		return false
+	})
+
+	return outpoints
+}
+
+func TestAddressIndex(t *testing.T) {
+	ctx, keeper := setupTestKeeper(t)
+	outpoints := putTestOutputs(ctx, keeper)
+
+	// The tx hash (0x11...) sorts before the account output ID (0xaa...).
+	tests := []struct {
+		address sdk.AccAddress
+		want    []OutPoint
+		balance uint64
+	}{
+		{testAddress1, []OutPoint{outpoints[1], outpoints[3], outpoints[0]}, 140},
+		{testAddress2, []OutPoint{outpoints[2]}, 20},
+		{testAddress3, nil, 0},
+	}
+
+	for _, test := range tests {
+		keys := getOutPointKeys(collectAddressUtxo(ctx, keeper, test.address, ""))
+		if fmt.Sprint(keys) != fmt.Sprint(getOutPointKeys(test.want)) {
+			t.Errorf("address %s outpoints = %v, want %v", test.address, keys, getOutPointKeys(test.want))
+		}
+
+		wallet := keeper.GetWallet(ctx, test.address)
+		if wallet.Balance != test.balance || len(wallet.Entries) != len(test.want) {
+			t.Errorf("address %s wallet = %+v, want balance %d with %d entries", test.address, wallet, test.balance, len(test.want))
+		}
+	}
+
+	// Spending outputs removes them from the index.
+	keeper.DeleteOutPoint(ctx, outpoints[0])
+	keeper.DeleteOutPoint(ctx, outpoints[2])
+
+	if keys := getOutPointKeys(collectAddressUtxo(ctx, keeper, testAddress1, "")); fmt.Sprint(keys) != fmt.Sprint(getOutPointKeys([]OutPoint{outpoints[1], outpoints[3]})) {
+		t.Errorf("address 1 outpoints after spending = %v", keys)
+	}
+
+	if wallet := keeper.GetWallet(ctx, testAddress2); wallet.Balance != 0 || len(wallet.Entries) != 0 {
+		t.Errorf("address 2 wallet after spending = %+v, want an empty wallet", wallet)
+	}
+
+	if keeper.HasOutPoint(ctx, outpoints[0]) || !keeper.HasOutPoint(ctx, outpoints[1]) {
+		t.Error("spent outpoint is still in the UTXO list, or unspent outpoint is missing")
+	}
+}
+
+func TestIterateUtxoAfter(t *testing.T) {
+	ctx, keeper := setupTestKeeper(t)
+	outpoints := putTestOutputs(ctx, keeper)
+
+	all := getOutPointKeys(keeper.ListUtxo(ctx))
+
+	tests := []struct {
+		name    string
+		address sdk.AccAddress
+		want    []string
+	}{
+		{"all", nil, all},
+		{"address", testAddress1, getOutPointKeys([]OutPoint{outpoints[1], outpoints[3], outpoints[0]})},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			iterate := func(after string, handler func(outpoint OutPoint) bool) {
+				if test.address == nil {
+					keeper.IterateUtxo(ctx, after, handler)
+				} else {
+					keeper.IterateAddressUtxo(ctx, test.address, after, handler)
+				}
+			}
+
+			// Page through the outpoints one at a time, starting after the last outpoint key.
+			keys := []string{}
+			after := ""
+			for pages := 0; pages <= len(test.want); pages++ {
+				var page []OutPoint
+				iterate(after, func(outpoint OutPoint) bool {
+					page = append(page, outpoint)
+					return true
+				})
+
+				if len(page) == 0 {
+					break
+				}
+
+				after = GetOutPointKey(page[0])
+				keys = append(keys, after)
+			}
+
+			if fmt.Sprint(keys) != fmt.Sprint(test.want) {
+				t.Errorf("outpoints = %v, want %v", keys, test.want)
+			}
+		})
+	}
+}
+
+func TestGenesisRebuildsAddressIndex(t *testing.T) {
+	ctx, keeper := setupTestKeeper(t)
+	outpoints := putTestOutputs(ctx, keeper)
+	keeper.DeleteOutPoint(ctx, outpoints[1])
+
+	data := ExportGenesis(ctx, keeper)
+	if err := ValidateGenesis(data); err != nil {
+		t.Fatalf("exported genesis state is invalid: %s", err)
+	}
+
+	importedCtx, imported := setupTestKeeper(t)
+	InitGenesis(importedCtx, imported, data)
+
+	if expected, actual := keeper.cdc.MustMarshalJSON(data), keeper.cdc.MustMarshalJSON(ExportGenesis(importedCtx, imported)); !bytes.Equal(expected, actual) {
+		t.Errorf("re-exported genesis state differs:\n%s\nwant:\n%s", actual, expected)
+	}
+
+	for _, address := range []sdk.AccAddress{testAddress1, testAddress2} {
+		expected, actual := keeper.GetWallet(ctx, address), imported.GetWallet(importedCtx, address)
+		if fmt.Sprintf("%+v", expected) != fmt.Sprintf("%+v", actual) {
+			t.Errorf("address %s imported wallet = %+v, want %+v", address, actual, expected)
+		}
+	}
+}
@@ -39,6 +39,10 @@ func (msg MsgBirthAccOutput) ValidateBasic() sdk.Error {
 		return sdk.ErrInsufficientCoins("Amount must be positive.")
 	}
 
+	if msg.Amount.Denom != Denom {
+		return sdk.ErrInvalidCoins("Amount must be in " + Denom + ".")
+	}
+
 	if msg.Address.Empty() {
 		return sdk.ErrInvalidAddress(msg.Address.String())
 	}
@@ -0,0 +1,70 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package utxo
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// DefaultParamspace is the param subspace of the UTXO module.
+const DefaultParamspace = "utxo"
+
+// DefaultMaxTxOutputs is the default max number of outputs of a transaction.
+const DefaultMaxTxOutputs int64 = 16
+
+// Keys for UTXO params.
+var (
+	KeyMaxTxOutputs = []byte("MaxTxOutputs")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params defines the UTXO limits.
+type Params struct {
+	// Max number of outputs of a transaction (0 for no limit).
+	MaxTxOutputs int64 `json:"max_tx_outputs"`
+}
+
+// KeyValuePairs implements params.ParamSet.
+func (p *Params) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{Key: KeyMaxTxOutputs, Value: &p.MaxTxOutputs},
+	}
+}
+
+// ParamTypeTable returns the param type table of the UTXO module.
+func ParamTypeTable() params.TypeTable {
+	return params.NewTypeTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default UTXO params.
+func DefaultParams() Params {
+	return Params{
+		MaxTxOutputs: DefaultMaxTxOutputs,
+	}
+}
+
+// Validate checks that the params are well formed.
+func (p Params) Validate() error {
+	if p.MaxTxOutputs < 0 {
+		return fmt.Errorf("max tx outputs must not be negative")
+	}
+
+	return nil
+}
+
+// GetParams - gets the UTXO params.
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams - saves the UTXO params.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
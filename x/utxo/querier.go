@@ -21,6 +21,7 @@ const (
 	GetTx         = "get-tx"
 	GetBalance    = "balance"
 	GetGraph      = "graph"
+	GetParams     = "params"
 )
 
 // NewQuerier is the module level router for state queries
@@ -39,6 +40,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return getBalance(ctx, path[1:], req, keeper)
 		case GetGraph:
 			return getGraph(ctx, path[1:], req, keeper)
+		case GetParams:
+			return getParams(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown utxo query endpoint.")
 		}
@@ -111,31 +114,7 @@ func getBalance(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdk.ErrInvalidAddress(path[0])
 	}
 
-	var wallet Wallet
-
-	// For each UTXO:
-	// Get the transaction output.
-	// Check if it's payable to the given address.
-	// If so, add UTXO value to current balance.
-	utxos := keeper.ListUtxo(ctx)
-	for _, outpoint := range utxos {
-		if outpoint.Index >= 0 {
-			tx := keeper.GetTx(ctx, outpoint.Hash)
-			txOut := tx.TxOut[outpoint.Index]
-
-			var obj PayToAddress
-			keeper.cdc.MustUnmarshalBinaryBare(txOut.PkScript, &obj)
-			if obj.Address.Equals(address) {
-				updateWallet(&wallet, outpoint, txOut.Value)
-			}
-
-		} else if outpoint.Index == OutPointAccountBirth {
-			accOutput := keeper.GetAccOutput(ctx, outpoint.Hash)
-			if accOutput.Address.Equals(address) {
-				updateWallet(&wallet, outpoint, accOutput.Value)
-			}
-		}
-	}
+	wallet := keeper.GetWallet(ctx, address)
 
 	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, wallet)
 	if err2 != nil {
@@ -145,15 +124,6 @@ func getBalance(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
-func updateWallet(wallet *Wallet, outpoint OutPoint, value uint64) {
-	wallet.Balance += value
-	wallet.Entries = append(wallet.Entries, OutPointVal{
-		Hash:  outpoint.Hash,
-		Index: outpoint.Index,
-		Value: value,
-	})
-}
-
 // nolint: unparam
 func getGraph(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
 	g := dot.NewGraph(dot.Directed)
@@ -173,3 +143,13 @@ func getGraph(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keep
 
 	return []byte(g.String()), nil
 }
+
+// nolint: unparam
+func getParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err2 != nil {
+		panic("Could not marshal result to JSON.")
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,21 @@
+//
+// Copyright 2019 Wireline, Inc.
+//
+
+package utxo
+
+// Tag keys added to the results of UTXO txs, used to index and query txs (e.g. `regcli query txs --tags`).
+// Outpoints are tagged as `<tx hash>:<output index>` (index -1 for account outputs).
+const (
+	TagAction          = "action" // Same as sdk.TagAction.
+	TagTxHash          = "utxo-tx-hash"
+	TagAddress         = "address"
+	TagSpentOutPoint   = "spent-outpoint"
+	TagCreatedOutPoint = "created-outpoint"
+)
+
+// Values of the action tag.
+const (
+	ActionBirthAccOutput = "birth-acc-output"
+	ActionTx             = "utxo-tx"
+)
@@ -12,6 +12,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Denom is the denomination of UTXO values (which are stored without a denomination).
+const Denom = "wire"
+
 // Hash represents a transaction or account output ID.
 type Hash []byte
 
@@ -20,6 +23,23 @@ func (h Hash) MarshalJSON() ([]byte, error) {
 	return json.Marshal(h.String())
 }
 
+// UnmarshalJSON unmarshals from the hex encoding (see MarshalJSON).
+func (h *Hash) UnmarshalJSON(bz []byte) error {
+	var str string
+	if err := json.Unmarshal(bz, &str); err != nil {
+		return err
+	}
+
+	hash, err := hex.DecodeString(str)
+	if err != nil {
+		return err
+	}
+
+	*h = hash
+
+	return nil
+}
+
 // String implements the Stringer interface.
 func (h Hash) String() string {
 	return strings.ToUpper(hex.EncodeToString(h))